@@ -178,6 +178,7 @@ func (p *Presenter) RunningTestsSummary(testingSummary ctests_tracker.TestingSum
 	passedTestsCount := testingSummary.PassedTestsCount
 	failedTestsCount := testingSummary.FailedTestsCount
 	skippedTestsCount := testingSummary.SkippedTestsCount
+	todoTestsCount := testingSummary.TodoTestsCount
 
 	packagesSummary += fmt.Sprintf("%d running", runningPackagesCount)
 
@@ -196,6 +197,9 @@ func (p *Presenter) RunningTestsSummary(testingSummary ctests_tracker.TestingSum
 	if skippedTestsCount > 0 {
 		testsSummary += ansi_escape.YELLOW + fmt.Sprintf("%d skipped", skippedTestsCount) + ansi_escape.COLOR_RESET + ", "
 	}
+	if todoTestsCount > 0 {
+		testsSummary += ansi_escape.MAGENTA + fmt.Sprintf("%d todo", todoTestsCount) + ansi_escape.COLOR_RESET + ", "
+	}
 	if passedTestsCount > 0 {
 		testsSummary += ansi_escape.GREEN + fmt.Sprintf("%d passed", passedTestsCount) + ansi_escape.COLOR_RESET + ", "
 	}
@@ -251,11 +255,47 @@ func buildFailuresNote(summary ctests_tracker.TestingSummary) string {
 	return ansi_escape.YELLOW + msg + ansi_escape.COLOR_RESET + "\n"
 }
 
+// focusedTestsNote returns a bold yellow warning line (terminated by a newline so
+// it sits on its own line above the packages tally) calling out TestOnly tests,
+// which keep the rest of their packages' tests from running. Returns "" when no
+// test is focused or skipped by focus.
+func focusedTestsNote(summary ctests_tracker.TestingSummary) string {
+	if summary.FocusedTestsCount == 0 && summary.SkippedByFocusTestsCount == 0 {
+		return ""
+	}
+	if summary.FocusedTestsCount == 0 {
+		// The focused tests were filtered out, by -run for instance, so nothing
+		// but the skipped ones hints that part of the run never happened.
+		skippedWord := "tests were"
+		if summary.SkippedByFocusTestsCount == 1 {
+			skippedWord = "test was"
+		}
+		msg := fmt.Sprintf(
+			"⚠ %d %s skipped by focus, but no TestOnly test ran. Remove TestOnly before committing.",
+			summary.SkippedByFocusTestsCount, skippedWord,
+		)
+		return ansi_escape.BOLD + ansi_escape.YELLOW + msg + ansi_escape.COLOR_RESET + "\n"
+	}
+	focusedWord := "test is"
+	if summary.FocusedTestsCount > 1 {
+		focusedWord = "tests are"
+	}
+	skippedWord := "tests were"
+	if summary.SkippedByFocusTestsCount == 1 {
+		skippedWord = "test was"
+	}
+	msg := fmt.Sprintf(
+		"⚠ %d %s focused with TestOnly; %d other %s skipped. Remove TestOnly before committing.",
+		summary.FocusedTestsCount, focusedWord, summary.SkippedByFocusTestsCount, skippedWord,
+	)
+	return ansi_escape.BOLD + ansi_escape.YELLOW + msg + ansi_escape.COLOR_RESET + "\n"
+}
+
 func (p *Presenter) TestingFinishedSummary(summary ctests_tracker.TestingSummary) {
 	// A build failure runs none of its package's tests, so the test counts below
 	// reflect only packages that compiled. Call that out explicitly, otherwise
 	// "612 passed, 612 total" reads as a clean run next to "1 failed".
-	packagesSummary := buildVerdictHeadline(summary) + buildFailuresNote(summary) + ansi_escape.BOLD + "Packages:" + ansi_escape.RESET_BOLD + " "
+	packagesSummary := buildVerdictHeadline(summary) + buildFailuresNote(summary) + focusedTestsNote(summary) + ansi_escape.BOLD + "Packages:" + ansi_escape.RESET_BOLD + " "
 	testsSummary := ansi_escape.BOLD + "Tests:" + ansi_escape.RESET_BOLD + "    "
 	timeSummary := fmt.Sprintf(ansi_escape.BOLD+"Time:"+ansi_escape.RESET_BOLD+"     %.3fs", summary.DurationS)
 
@@ -285,6 +325,11 @@ func (p *Presenter) TestingFinishedSummary(summary ctests_tracker.TestingSummary
 			fmt.Sprintf("%d skipped", summary.SkippedTestsCount) +
 			ansi_escape.COLOR_RESET + ", "
 	}
+	if summary.TodoTestsCount > 0 {
+		testsSummary += ansi_escape.MAGENTA +
+			fmt.Sprintf("%d todo", summary.TodoTestsCount) +
+			ansi_escape.COLOR_RESET + ", "
+	}
 	if summary.PassedTestsCount > 0 {
 		testsSummary += ansi_escape.GREEN +
 			fmt.Sprintf("%d passed", summary.PassedTestsCount) +
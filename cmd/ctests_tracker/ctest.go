@@ -24,6 +24,14 @@ type Ctest struct {
 	isSkipped   bool
 	ranAt       time.Time
 	durationS   float64
+
+	// isTodo marks a skipped TestTodo placeholder, counted in its own category.
+	isTodo bool
+	// isFocused marks a TestOnly test.
+	isFocused bool
+	// isSkippedByFocus marks a skipped test that did not run because its package
+	// contains TestOnly tests.
+	isSkippedByFocus bool
 }
 
 // ctestDurationS returns a test's elapsed time in seconds. It trusts Go's
@@ -68,6 +76,7 @@ func NewRunningCtest(ranEvt events.CtestRanEvent) Ctest {
 		hasFailed:   false,
 		isSkipped:   false,
 		ranAt:       ranEvt.Time,
+		isFocused:   ranEvt.IsFocused(),
 	}
 }
 
@@ -81,6 +90,7 @@ func NewPassedCtest(passedEvt events.CtestPassedEvent) Ctest {
 		hasFailed:   false,
 		isSkipped:   false,
 		durationS:   ctestDurationS(time.Time{}, passedEvt.Time, passedEvt.Elapsed),
+		isFocused:   passedEvt.IsFocused(),
 	}
 }
 
@@ -94,6 +104,7 @@ func NewFailedCtest(failedEvt events.CtestFailedEvent) Ctest {
 		hasFailed:   true,
 		isSkipped:   false,
 		durationS:   ctestDurationS(time.Time{}, failedEvt.Time, failedEvt.Elapsed),
+		isFocused:   failedEvt.IsFocused(),
 	}
 }
 
@@ -106,6 +117,9 @@ func NewSkippedCtest(skippedEvt events.CtestSkippedEvent) Ctest {
 		hasPassed:   false,
 		hasFailed:   false,
 		isSkipped:   true,
+
+		isTodo:           skippedEvt.IsTodo(),
+		isSkippedByFocus: skippedEvt.IsSkippedByFocus(),
 	}
 }
 
@@ -142,6 +156,23 @@ func (ctest *Ctest) IsSkipped() bool {
 	return ctest.isSkipped
 }
 
+// IsTodo reports whether the test is a TestTodo placeholder. A todo test is also
+// skipped, but it is counted separately from the other skipped tests.
+func (ctest *Ctest) IsTodo() bool {
+	return ctest.isTodo
+}
+
+// IsFocused reports whether the test was declared with TestOnly.
+func (ctest *Ctest) IsFocused() bool {
+	return ctest.isFocused
+}
+
+// IsSkippedByFocus reports whether the test was skipped because its package
+// contains TestOnly tests.
+func (ctest *Ctest) IsSkippedByFocus() bool {
+	return ctest.isSkippedByFocus
+}
+
 func (ctest *Ctest) HasPassed() bool {
 	return ctest.hasPassed
 }
@@ -175,6 +206,7 @@ func (ctest *Ctest) MarkAsPassed(passedEvt events.CtestPassedEvent) {
 	ctest.hasFailed = false
 	ctest.isSkipped = false
 	ctest.durationS = ctestDurationS(ctest.ranAt, passedEvt.Time, passedEvt.Elapsed)
+	ctest.isFocused = passedEvt.IsFocused()
 }
 
 func (ctest *Ctest) MarkAsFailed(failedEvt events.CtestFailedEvent) {
@@ -183,6 +215,7 @@ func (ctest *Ctest) MarkAsFailed(failedEvt events.CtestFailedEvent) {
 	ctest.hasFailed = true
 	ctest.isSkipped = false
 	ctest.durationS = ctestDurationS(ctest.ranAt, failedEvt.Time, failedEvt.Elapsed)
+	ctest.isFocused = failedEvt.IsFocused()
 }
 
 func (ctest *Ctest) MarkAsSkipped(skippedEvt events.CtestSkippedEvent) {
@@ -190,6 +223,8 @@ func (ctest *Ctest) MarkAsSkipped(skippedEvt events.CtestSkippedEvent) {
 	ctest.hasPassed = false
 	ctest.hasFailed = false
 	ctest.isSkipped = true
+	ctest.isTodo = skippedEvt.IsTodo()
+	ctest.isSkippedByFocus = skippedEvt.IsSkippedByFocus()
 }
 
 func (ctest *Ctest) Equals(otherCtest Ctest) bool {
@@ -276,6 +276,30 @@ func (tracker *CtestsTracker) SkippedCtestsCount() int {
 	return count
 }
 
+func (tracker *CtestsTracker) TodoCtestsCount() int {
+	count := 0
+	for _, packageUt := range tracker.packagesUnderTest {
+		count += packageUt.TodoCtestsCount()
+	}
+	return count
+}
+
+func (tracker *CtestsTracker) FocusedCtestsCount() int {
+	count := 0
+	for _, packageUt := range tracker.packagesUnderTest {
+		count += packageUt.FocusedCtestsCount()
+	}
+	return count
+}
+
+func (tracker *CtestsTracker) SkippedByFocusCtestsCount() int {
+	count := 0
+	for _, packageUt := range tracker.packagesUnderTest {
+		count += packageUt.SkippedByFocusCtestsCount()
+	}
+	return count
+}
+
 func (tracker *CtestsTracker) PassedPackagesCount() int {
 	count := 0
 	for _, packageUt := range tracker.packagesUnderTest {
@@ -380,8 +404,12 @@ func (tracker *CtestsTracker) TestingSummary() TestingSummary {
 		PassedTestsCount:  tracker.PassedCtestsCount(),
 		FailedTestsCount:  tracker.FailedCtestsCount(),
 		SkippedTestsCount: tracker.SkippedCtestsCount(),
+		TodoTestsCount:    tracker.TodoCtestsCount(),
 		RunningTestsCount: tracker.RunningCtestsCount(),
 
+		FocusedTestsCount:        tracker.FocusedCtestsCount(),
+		SkippedByFocusTestsCount: tracker.SkippedByFocusCtestsCount(),
+
 		DurationS: float32(duration.Seconds()),
 	}
 }
@@ -422,5 +450,5 @@ func (tracker *CtestsTracker) replacePackageWith(packageName string, replacement
 	if packageIndex == -1 {
 		return
 	}
-	slices.Replace(tracker.packagesUnderTest, packageIndex, packageIndex+1, replacement)
+	tracker.packagesUnderTest = slices.Replace(tracker.packagesUnderTest, packageIndex, packageIndex+1, replacement)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/redjolr/goherent/cmd/ctests_tracker"
 	"github.com/redjolr/goherent/cmd/events"
 	"github.com/redjolr/goherent/expect"
+	"github.com/redjolr/goherent/internal"
 
 	. "github.com/redjolr/goherent/test"
 )
@@ -305,6 +306,54 @@ func TestHandleCtestSkippedEvent(t *testing.T) {
 	}, t)
 }
 
+func TestTodoAndFocusedCtests(t *testing.T) {
+	Test(`
+	Given that there is an empty CtestsTracker
+	When a CtestSkippedEvent for the TestTodo "ParentTest/someTodo" and one for the skipped test "ParentTest/someTest" occur
+	Then the todo test is counted as todo and not as skipped
+	And the package is reported as skipped once testing finishes`, func(Expect expect.F) {
+		// Given
+		tracker := ctests_tracker.NewCtestsTracker()
+		todoName := "ParentTest/" + internal.EncodeGoherentMarkedTestName(internal.ENCODED_TODO, "someTodo")
+
+		// When
+		tracker.HandleCtestSkippedEvent(makeCtestSkippedEvent("somePackage", todoName))
+		tracker.HandleCtestSkippedEvent(makeCtestSkippedEvent("somePackage", "ParentTest/someTest"))
+		tracker.TestingFinished(events.NewTestingFinishedEvent(time.Now()))
+
+		// Then
+		todo := tracker.FindCtestWithNameInPackage("ParentTest/someTodo", "somePackage")
+		Expect(todo.IsTodo()).ToBeTrue()
+		summary := tracker.TestingSummary()
+		Expect(summary.TodoTestsCount).ToEqual(1)
+		Expect(summary.SkippedTestsCount).ToEqual(1)
+		Expect(summary.TestsCount).ToEqual(2)
+		Expect(summary.SkippedPackagesCount).ToEqual(1)
+	}, t)
+
+	Test(`
+	Given that there is an empty CtestsTracker
+	When the TestOnly "ParentTest/focused" passes and "ParentTest/other" is skipped by focus
+	Then the summary counts one focused test and one test skipped by focus`, func(Expect expect.F) {
+		// Given
+		tracker := ctests_tracker.NewCtestsTracker()
+		focusedName := "ParentTest/" + internal.EncodeGoherentMarkedTestName(internal.ENCODED_FOCUSED, "focused")
+		unfocusedName := "ParentTest/" + internal.EncodeGoherentMarkedTestName(internal.ENCODED_SKIPPED_BY_FOCUS, "other")
+
+		// When
+		tracker.HandleCtestRanEvent(makeCtestRanEvent("somePackage", focusedName))
+		tracker.HandleCtestPassedEvent(makeCtestPassedEvent("somePackage", focusedName))
+		tracker.HandleCtestSkippedEvent(makeCtestSkippedEvent("somePackage", unfocusedName))
+
+		// Then
+		summary := tracker.TestingSummary()
+		Expect(summary.FocusedTestsCount).ToEqual(1)
+		Expect(summary.SkippedByFocusTestsCount).ToEqual(1)
+		Expect(summary.SkippedTestsCount).ToEqual(1)
+		Expect(summary.PassedTestsCount).ToEqual(1)
+	}, t)
+}
+
 func TestNewCtestOutput(t *testing.T) {
 	Test(`
 	Given that there is a Ctest with name "ParentTest/someTest" of package "somePackage"
@@ -426,6 +475,25 @@ func TestNewCtestOutput(t *testing.T) {
 		// Then
 		Expect(ctestOutput).ToEqual("some output")
 	}, t)
+
+	Test(`
+	Given a CtestOutputEvent has occurred for the Describe block "ParentTest/someBlock" of "somePackage"
+	When the tracker handles the event
+	Then no Ctest is created for the Describe block
+	And the output is recorded as output of a parent test`, func(Expect expect.F) {
+		// Given
+		tracker := ctests_tracker.NewCtestsTracker()
+		describeBlockName := "ParentTest/" + internal.EncodeGoherentDescribeName("someBlock")
+		ctestOutputEvt := makeCtestOutputEvent("somePackage", describeBlockName, "AfterAll hook failed\n")
+
+		// When
+		tracker.HandleCtestOutputEvent(ctestOutputEvt)
+
+		// Then
+		packageUt := tracker.FindPackageWithName("somePackage")
+		Expect(packageUt.CtestsCount()).ToEqual(0)
+		Expect(packageUt.ParentTestsOutput()).ToEqual("AfterAll hook failed\n")
+	}, t)
 }
 
 func TestInsertCtest(t *testing.T) {
@@ -496,6 +564,30 @@ func TestInsertCtest(t *testing.T) {
 		Expect(testInPackage).NotToBeNil()
 		Expect(tracker.PackagesCount()).ToEqual(2)
 	}, t)
+
+	Test(`
+	Given that we have a CtestsTracker
+	And that tracker has the PackageUnderTests "packageName1" and "packageName2", in that order
+	When we call the InsertCtest() method with a Ctest { name: "ctestName3", packageName: "packageName1" }
+	Then the tracker should still have the two packages, in the same order
+	And the ctestName3 Ctest will be added to "packageName1"
+	`, func(Expect expect.F) {
+		// Given
+		tracker := ctests_tracker.NewCtestsTracker()
+		tracker.InsertCtest(ctests_tracker.NewCtest("ctestName1", "packageName1"))
+		tracker.InsertCtest(ctests_tracker.NewCtest("ctestName2", "packageName2"))
+
+		// When
+		tracker.InsertCtest(ctests_tracker.NewCtest("ctestName3", "packageName1"))
+
+		// Then
+		packages := tracker.Packages()
+		Expect(packages).ToHaveLength(2)
+		Expect(packages[0].Name()).ToEqual("packageName1")
+		Expect(packages[1].Name()).ToEqual("packageName2")
+		Expect(packages[0].CtestsCount()).ToEqual(2)
+		Expect(tracker.FindCtestWithNameInPackage("ctestName3", "packageName1")).NotToBeNil()
+	}, t)
 }
 
 func TestIsCtestFirstOfItsPackage(t *testing.T) {
@@ -82,10 +82,42 @@ func (packageUt *PackageUnderTest) FailedCtests() []Ctest {
 	return failedCtests
 }
 
+// SkippedCtestsCount is the number of skipped tests, not counting TestTodo
+// placeholders (see TodoCtestsCount).
 func (packageUt *PackageUnderTest) SkippedCtestsCount() int {
 	count := 0
 	for _, ctest := range packageUt.ctests {
-		if ctest.isSkipped {
+		if ctest.isSkipped && !ctest.isTodo {
+			count++
+		}
+	}
+	return count
+}
+
+func (packageUt *PackageUnderTest) TodoCtestsCount() int {
+	count := 0
+	for _, ctest := range packageUt.ctests {
+		if ctest.isTodo {
+			count++
+		}
+	}
+	return count
+}
+
+func (packageUt *PackageUnderTest) FocusedCtestsCount() int {
+	count := 0
+	for _, ctest := range packageUt.ctests {
+		if ctest.isFocused {
+			count++
+		}
+	}
+	return count
+}
+
+func (packageUt *PackageUnderTest) SkippedByFocusCtestsCount() int {
+	count := 0
+	for _, ctest := range packageUt.ctests {
+		if ctest.isSkippedByFocus {
 			count++
 		}
 	}
@@ -125,7 +157,7 @@ func (packageUt *PackageUnderTest) HasAtLeastOneSkippedTest() bool {
 
 func (packageUt *PackageUnderTest) HasPassed() bool {
 	return !packageUt.TestsAreRunning() && packageUt.PassedCtestsCount() > 0 &&
-		packageUt.PassedCtestsCount()+packageUt.SkippedCtestsCount() == len(packageUt.ctests)
+		packageUt.PassedCtestsCount()+packageUt.SkippedCtestsCount()+packageUt.TodoCtestsCount() == len(packageUt.ctests)
 }
 
 func (packageUt *PackageUnderTest) IsSkipped() bool {
@@ -134,7 +166,7 @@ func (packageUt *PackageUnderTest) IsSkipped() bool {
 	// failure is a failure, not a skip, so exclude it explicitly.
 	return !packageUt.TestsAreRunning() &&
 		!packageUt.buildFailed &&
-		packageUt.SkippedCtestsCount() == len(packageUt.ctests)
+		packageUt.SkippedCtestsCount()+packageUt.TodoCtestsCount() == len(packageUt.ctests)
 }
 
 // MarkAsBuildFailed records that this package failed to compile, so it ran no
@@ -12,7 +12,14 @@ type TestingSummary struct {
 	PassedTestsCount  int
 	FailedTestsCount  int
 	SkippedTestsCount int
+	TodoTestsCount    int
 	RunningTestsCount int
 
+	// FocusedTestsCount is the number of TestOnly tests. When it is non-zero the
+	// other tests of their packages did not run; SkippedByFocusTestsCount of the
+	// skipped tests were skipped for that reason.
+	FocusedTestsCount        int
+	SkippedByFocusTestsCount int
+
 	DurationS float32
 }
@@ -12,6 +12,9 @@ type CtestFailedEvent struct {
 	PackageName string
 	TestName    string
 	Elapsed     float64
+
+	isDescribeBlock bool
+	isFocused       bool
 }
 
 func NewCtestFailedEvent(jsonEvt JsonTestEvent) CtestFailedEvent {
@@ -20,9 +23,19 @@ func NewCtestFailedEvent(jsonEvt JsonTestEvent) CtestFailedEvent {
 		PackageName: jsonEvt.Package,
 		TestName:    internal.DecodeGoherentTestName(jsonEvt.Test),
 		Elapsed:     *jsonEvt.Elapsed,
+
+		isDescribeBlock: internal.IsGoherentDescribeBlock(jsonEvt.Test),
+		isFocused:       internal.HasGoherentMarker(jsonEvt.Test, internal.ENCODED_FOCUSED),
 	}
 }
 
+// IsEventOfAParentTest reports whether the event belongs to a test that only
+// groups other tests: a top-level test function or a goherent Describe block.
 func (e CtestFailedEvent) IsEventOfAParentTest() bool {
-	return !strings.Contains(e.TestName, "/")
+	return e.isDescribeBlock || !strings.Contains(e.TestName, "/")
+}
+
+// IsFocused reports whether the test was declared with TestOnly.
+func (e CtestFailedEvent) IsFocused() bool {
+	return e.isFocused
 }
@@ -12,6 +12,8 @@ type CtestOutputEvent struct {
 	PackageName string
 	TestName    string
 	Output      string
+
+	isDescribeBlock bool
 }
 
 func NewCtestOutputEvent(jsonEvt JsonTestEvent) CtestOutputEvent {
@@ -20,11 +22,15 @@ func NewCtestOutputEvent(jsonEvt JsonTestEvent) CtestOutputEvent {
 		PackageName: jsonEvt.Package,
 		TestName:    internal.DecodeGoherentTestName(jsonEvt.Test),
 		Output:      jsonEvt.Output,
+
+		isDescribeBlock: internal.IsGoherentDescribeBlock(jsonEvt.Test),
 	}
 }
 
+// IsEventOfAParentTest reports whether the event belongs to a test that only
+// groups other tests: a top-level test function or a goherent Describe block.
 func (e CtestOutputEvent) IsEventOfAParentTest() bool {
-	return !strings.Contains(e.TestName, "/")
+	return e.isDescribeBlock || !strings.Contains(e.TestName, "/")
 }
 
 func (e CtestOutputEvent) IsAGenericRunPassFailOutput() bool {
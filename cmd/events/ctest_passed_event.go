@@ -11,6 +11,8 @@ type CtestPassedEvent struct {
 	PackageName string
 	TestName    string
 	Elapsed     float64
+
+	isFocused bool
 }
 
 func NewCtestPassedEvent(jsonEvt JsonTestEvent) CtestPassedEvent {
@@ -19,5 +21,12 @@ func NewCtestPassedEvent(jsonEvt JsonTestEvent) CtestPassedEvent {
 		PackageName: jsonEvt.Package,
 		TestName:    internal.DecodeGoherentTestName(jsonEvt.Test),
 		Elapsed:     *jsonEvt.Elapsed,
+
+		isFocused: internal.HasGoherentMarker(jsonEvt.Test, internal.ENCODED_FOCUSED),
 	}
 }
+
+// IsFocused reports whether the test was declared with TestOnly.
+func (e CtestPassedEvent) IsFocused() bool {
+	return e.isFocused
+}
@@ -10,6 +10,8 @@ type CtestRanEvent struct {
 	Time        time.Time
 	PackageName string
 	TestName    string
+
+	isFocused bool
 }
 
 func NewCtestRanEvent(jsonEvt JsonTestEvent) CtestRanEvent {
@@ -17,5 +19,12 @@ func NewCtestRanEvent(jsonEvt JsonTestEvent) CtestRanEvent {
 		Time:        jsonEvt.Time,
 		PackageName: jsonEvt.Package,
 		TestName:    internal.DecodeGoherentTestName(jsonEvt.Test),
+
+		isFocused: internal.HasGoherentMarker(jsonEvt.Test, internal.ENCODED_FOCUSED),
 	}
 }
+
+// IsFocused reports whether the test was declared with TestOnly.
+func (e CtestRanEvent) IsFocused() bool {
+	return e.isFocused
+}
@@ -11,6 +11,9 @@ type CtestSkippedEvent struct {
 	PackageName string
 	TestName    string
 	Elapsed     *float64
+
+	isTodo           bool
+	isSkippedByFocus bool
 }
 
 func NewCtestSkippedEvent(jsonEvt JsonTestEvent) CtestSkippedEvent {
@@ -19,5 +22,19 @@ func NewCtestSkippedEvent(jsonEvt JsonTestEvent) CtestSkippedEvent {
 		PackageName: jsonEvt.Package,
 		TestName:    internal.DecodeGoherentTestName(jsonEvt.Test),
 		Elapsed:     jsonEvt.Elapsed,
+
+		isTodo:           internal.HasGoherentMarker(jsonEvt.Test, internal.ENCODED_TODO),
+		isSkippedByFocus: internal.HasGoherentMarker(jsonEvt.Test, internal.ENCODED_SKIPPED_BY_FOCUS),
 	}
 }
+
+// IsTodo reports whether the skipped test is a TestTodo placeholder.
+func (e CtestSkippedEvent) IsTodo() bool {
+	return e.isTodo
+}
+
+// IsSkippedByFocus reports whether the test was skipped because its package
+// contains TestOnly tests.
+func (e CtestSkippedEvent) IsSkippedByFocus() bool {
+	return e.isSkippedByFocus
+}
@@ -11,6 +11,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/redjolr/goherent/cmd/concurrent_events"
@@ -42,23 +43,30 @@ func Main(extraCmdArgs []string) int {
 		}
 
 		var wg sync.WaitGroup
+		var sawFocusedTests atomic.Bool
 		wg.Add(2)
-		go decodeAndForward(stdout, os.Stdout, &wg)
-		go decodeAndForward(stderr, os.Stderr, &wg)
+		go decodeAndForward(stdout, os.Stdout, &sawFocusedTests, &wg)
+		go decodeAndForward(stderr, os.Stderr, &sawFocusedTests, &wg)
 		wg.Wait()
 
 		if err := cmd.Wait(); err != nil {
 			fmt.Fprintf(os.Stderr, "command failed: %v\n", err)
 		}
+		if sawFocusedTests.Load() {
+			fmt.Fprintln(os.Stderr, "\n⚠ Tests focused with TestOnly ran, so the other tests of their packages were skipped. Remove TestOnly before committing.")
+		}
 		return cmd.ProcessState.ExitCode()
 	}
 
 	router := setup()
 
 	testCmd := NewTestCmd(extraCmdArgs)
-	testCmd.
-		NonVerbose().
-		Exec()
+	testCmd.NonVerbose()
+	// Coloured diffs are written as ANSI escapes, which only a terminal renders.
+	if consolesize.IsTerminal() && os.Getenv("CI") == "" {
+		testCmd.Colored()
+	}
+	testCmd.Exec()
 	concurrently := testCmd.RunsTestsConcurrently()
 	router.RouteTestingStartedEvent(concurrently)
 
@@ -147,10 +155,17 @@ func setup() *Router {
 	return &router
 }
 
-func decodeAndForward(src io.Reader, dst io.Writer, wg *sync.WaitGroup) {
+// decodeAndForward copies src to dst line by line, decoding goherent test names.
+// It flags sawFocusedTests when a line mentions a TestOnly test, whose marker is
+// lost in decoding, so the run can end with a warning.
+func decodeAndForward(src io.Reader, dst io.Writer, sawFocusedTests *atomic.Bool, wg *sync.WaitGroup) {
 	defer wg.Done()
 	scanner := bufio.NewScanner(src)
 	for scanner.Scan() {
-		fmt.Fprintln(dst, internal.DecodeGoherentTestName(scanner.Text()))
+		line := scanner.Text()
+		if strings.Contains(line, internal.ENCODED_FOCUSED) {
+			sawFocusedTests.Store(true)
+		}
+		fmt.Fprintln(dst, internal.DecodeGoherentTestName(line))
 	}
 }
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/redjolr/goherent/cmd/mockgen"
+	"golang.org/x/tools/go/packages"
+)
+
+// MockMain runs `goherent mock [-o file] [-pkg name] [-tags list] <package> <Interface>`:
+// it generates a mock of the interface, backed by expect.Fn.
+func MockMain(args []string) int {
+	flags := flag.NewFlagSet("goherent mock", flag.ContinueOnError)
+	outputPath := flags.String("o", "", "write the mock to this file instead of stdout")
+	outPkgName := flags.String("pkg", "", "package name of the generated file (default: the interface's package name followed by \"mock\")")
+	buildTags := flags.String("tags", "", "comma-separated build tags to load the package with, as for go build")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: goherent mock [-o file] [-pkg name] [-tags list] <package> <Interface>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+	pkgPath, ifaceName := flags.Arg(0), flags.Arg(1)
+
+	// Relative paths such as ./store are resolved by go list, under the build
+	// flags given, so the mock imports the package by its import path. The
+	// packages are type-checked from source rather than from export data, which
+	// a given x/tools release can only read for the Go versions it knows.
+	config := &packages.Config{Mode: packages.LoadAllSyntax}
+	if *buildTags != "" {
+		config.BuildFlags = []string{"-tags=" + *buildTags}
+	}
+	loaded, err := packages.Load(config, pkgPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "goherent mock: loading %s: %v\n", pkgPath, err)
+		return 1
+	}
+	if len(loaded) != 1 {
+		fmt.Fprintf(os.Stderr, "goherent mock: %s matches %d packages, not one\n", pkgPath, len(loaded))
+		return 1
+	}
+	if packages.PrintErrors(loaded) > 0 {
+		fmt.Fprintf(os.Stderr, "goherent mock: loading %s failed\n", pkgPath)
+		return 1
+	}
+	pkg := loaded[0].Types
+	if *outPkgName == "" {
+		*outPkgName = pkg.Name() + "mock"
+	}
+
+	src, err := mockgen.Generate(pkg, ifaceName, *outPkgName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "goherent mock: %v\n", err)
+		return 1
+	}
+	if *outputPath == "" {
+		os.Stdout.Write(src)
+		return 0
+	}
+	if err := os.WriteFile(*outputPath, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "goherent mock: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,242 @@
+// Package mockgen generates mocks of Go interfaces backed by expect.Fn, so their
+// calls are checked with the same matchers as any mock function:
+//
+//	store := storemock.NewMockStore()
+//	store.Save.Returns(nil)
+//	NewService(store.Impl()).Register("ada")
+//	Expect(store.Save).ToHaveBeenCalledWith(User{Name: "ada"})
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const expectImportPath = "github.com/redjolr/goherent/expect"
+
+// receiverName is the receiver of the generated interface methods. Parameters
+// named like it, or like an imported package, are renamed.
+const receiverName = "impl"
+
+// Generate returns the source of a mock of the interface named ifaceName in pkg,
+// as a file of the package named outPkgName. When outPkgName is the name of pkg,
+// the mock is generated to sit in pkg itself, and its types are not qualified.
+//
+// The mock of an interface Store is a MockStore struct with one *expect.Mock
+// field per method, named after it, and an Impl method returning the Store
+// implementation that calls them.
+func Generate(pkg *types.Package, ifaceName string, outPkgName string) ([]byte, error) {
+	obj := pkg.Scope().Lookup(ifaceName)
+	if obj == nil {
+		return nil, fmt.Errorf("%s has no type %s", pkg.Path(), ifaceName)
+	}
+	typeName, ok := obj.(*types.TypeName)
+	if !ok || !types.IsInterface(typeName.Type()) {
+		return nil, fmt.Errorf("%s.%s is not an interface", pkg.Name(), ifaceName)
+	}
+	named, ok := typeName.Type().(*types.Named)
+	if ok && named.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf("%s.%s is generic, which is not supported", pkg.Name(), ifaceName)
+	}
+	iface := typeName.Type().Underlying().(*types.Interface)
+	if !iface.IsMethodSet() {
+		return nil, fmt.Errorf("%s.%s is a constraint, not an interface that can be implemented", pkg.Name(), ifaceName)
+	}
+
+	inPackage := outPkgName == pkg.Name()
+	imports := newImports(pkg, inPackage)
+	imports.add(expectImportPath, "expect")
+	mockName := "Mock" + ifaceName
+	ifaceType := types.TypeString(typeName.Type(), imports.qualifier)
+
+	methods := []*types.Func{}
+	for i := 0; i < iface.NumMethods(); i++ {
+		method := iface.Method(i)
+		if !method.Exported() && !inPackage {
+			return nil, fmt.Errorf("%s.%s has the unexported method %s, so it can only be mocked in package %s", pkg.Name(), ifaceName, method.Name(), pkg.Name())
+		}
+		if method.Name() == "Impl" {
+			return nil, fmt.Errorf("%s.%s has a method named Impl, which the mock needs for itself", pkg.Name(), ifaceName)
+		}
+		methods = append(methods, method)
+		// Collect the imports up front, so no parameter is named like one.
+		types.TypeString(method.Type(), imports.qualifier)
+	}
+
+	var fields, constructors, implementations strings.Builder
+	for _, method := range methods {
+		signature := method.Type().(*types.Signature)
+		params := paramNames(signature, imports)
+		funcType := funcTypeString(signature, params, imports)
+
+		fmt.Fprintf(&fields, "\t%s *expect.Mock[%s]\n", method.Name(), funcType)
+		fmt.Fprintf(&constructors, "\t\t%s: expect.Fn[%s](),\n", method.Name(), funcType)
+
+		args := strings.Join(params, ", ")
+		if signature.Variadic() {
+			args += "..."
+		}
+		call := fmt.Sprintf("%s.mock.%s.Func()(%s)", receiverName, method.Name(), args)
+		if signature.Results().Len() > 0 {
+			call = "return " + call
+		}
+		fmt.Fprintf(
+			&implementations, "\nfunc (%s mock%sImpl) %s%s {\n\t%s\n}\n",
+			receiverName, ifaceName, method.Name(), strings.TrimPrefix(funcType, "func"), call,
+		)
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by goherent mock. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", outPkgName)
+	src.WriteString(imports.declaration())
+	fmt.Fprintf(&src, "\n// %s is a mock of %s. Its fields record the calls of each\n", mockName, ifaceType)
+	fmt.Fprintf(&src, "// method and set what they return; Impl returns the %s making them.\n", ifaceType)
+	fmt.Fprintf(&src, "type %s struct {\n%s}\n", mockName, fields.String())
+	fmt.Fprintf(&src, "\n// New%s returns a %s whose methods return zero values until set\n", mockName, mockName)
+	fmt.Fprintf(&src, "// otherwise with Returns or ReturnsOnce.\n")
+	fmt.Fprintf(&src, "func New%s() *%s {\n\treturn &%s{\n%s\t}\n}\n", mockName, mockName, mockName, constructors.String())
+	fmt.Fprintf(&src, "\n// Impl returns the %s implementation backed by the mock.\n", ifaceType)
+	fmt.Fprintf(&src, "func (m *%s) Impl() %s {\n\treturn mock%sImpl{mock: m}\n}\n", mockName, ifaceType, ifaceName)
+	fmt.Fprintf(&src, "\ntype mock%sImpl struct {\n\tmock *%s\n}\n", ifaceName, mockName)
+	src.WriteString(implementations.String())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting the generated mock: %w", err)
+	}
+	return formatted, nil
+}
+
+// paramNames names the parameters of a method: their own names where usable,
+// argN otherwise, with N raised past any name the signature already has.
+func paramNames(signature *types.Signature, imports *imports) []string {
+	names := make([]string, signature.Params().Len())
+	taken := map[string]bool{}
+	for i := range names {
+		name := signature.Params().At(i).Name()
+		if name == "" || name == "_" || name == receiverName || imports.isName(name) {
+			continue
+		}
+		names[i] = name
+		taken[name] = true
+	}
+	for i, name := range names {
+		if name != "" {
+			continue
+		}
+		n := i
+		for taken["arg"+strconv.Itoa(n)] || imports.isName("arg"+strconv.Itoa(n)) {
+			n++
+		}
+		names[i] = "arg" + strconv.Itoa(n)
+		taken[names[i]] = true
+	}
+	return names
+}
+
+// funcTypeString writes the type of a method as a func type with named
+// parameters, such as func(id string) (store.User, error).
+func funcTypeString(signature *types.Signature, params []string, imports *imports) string {
+	paramList := make([]string, len(params))
+	for i, name := range params {
+		paramType := signature.Params().At(i).Type()
+		if signature.Variadic() && i == len(params)-1 {
+			paramList[i] = name + " ..." + types.TypeString(paramType.(*types.Slice).Elem(), imports.qualifier)
+			continue
+		}
+		paramList[i] = name + " " + types.TypeString(paramType, imports.qualifier)
+	}
+	results := make([]string, signature.Results().Len())
+	for i := range results {
+		results[i] = types.TypeString(signature.Results().At(i).Type(), imports.qualifier)
+	}
+
+	funcType := "func(" + strings.Join(paramList, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		funcType += " " + results[0]
+	default:
+		funcType += " (" + strings.Join(results, ", ") + ")"
+	}
+	return funcType
+}
+
+// imports collects the packages the generated mock refers to, each under a name
+// unique in the file.
+type imports struct {
+	pkg       *types.Package
+	inPackage bool
+	names     map[string]string // import path -> name
+}
+
+func newImports(pkg *types.Package, inPackage bool) *imports {
+	return &imports{pkg: pkg, inPackage: inPackage, names: map[string]string{}}
+}
+
+func (im *imports) add(path, name string) string {
+	if existing, ok := im.names[path]; ok {
+		return existing
+	}
+	unique := name
+	for n := 2; im.isName(unique) || token.Lookup(unique).IsKeyword(); n++ {
+		unique = name + strconv.Itoa(n)
+	}
+	im.names[path] = unique
+	return unique
+}
+
+func (im *imports) isName(name string) bool {
+	for _, existing := range im.names {
+		if existing == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (im *imports) qualifier(pkg *types.Package) string {
+	if im.inPackage && pkg == im.pkg {
+		return ""
+	}
+	return im.add(pkg.Path(), pkg.Name())
+}
+
+func (im *imports) declaration() string {
+	paths := make([]string, 0, len(im.names))
+	for path := range im.names {
+		paths = append(paths, path)
+	}
+	// Standard library packages first, as goimports groups them.
+	isStd := func(path string) bool {
+		return path != im.pkg.Path() && !strings.Contains(strings.Split(path, "/")[0], ".")
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if isStd(paths[i]) != isStd(paths[j]) {
+			return isStd(paths[i])
+		}
+		return paths[i] < paths[j]
+	})
+	var declaration strings.Builder
+	declaration.WriteString("import (\n")
+	for i, path := range paths {
+		if i > 0 && isStd(paths[i-1]) && !isStd(path) {
+			declaration.WriteString("\n")
+		}
+		name := im.names[path]
+		if name == path[strings.LastIndex(path, "/")+1:] {
+			fmt.Fprintf(&declaration, "\t%q\n", path)
+		} else {
+			fmt.Fprintf(&declaration, "\t%s %q\n", name, path)
+		}
+	}
+	declaration.WriteString(")\n")
+	return declaration.String()
+}
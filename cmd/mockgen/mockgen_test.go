@@ -0,0 +1,211 @@
+package mockgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// typeCheck type-checks src as the package example.com/app/store.
+func typeCheck(t *testing.T, src string, imp types.Importer) *types.Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "store.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: imp}
+	pkg, err := conf.Check("example.com/app/store", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
+
+// packageImporter imports the packages loaded by loadImporter, and store when
+// it is set.
+type packageImporter struct {
+	loaded map[string]*types.Package
+	store  *types.Package
+}
+
+func (i packageImporter) Import(path string) (*types.Package, error) {
+	if i.store != nil && path == i.store.Path() {
+		return i.store, nil
+	}
+	if pkg, ok := i.loaded[path]; ok {
+		return pkg, nil
+	}
+	return nil, fmt.Errorf("package %s is not loaded", path)
+}
+
+// loadedOnly returns the importer without the store package, to type-check
+// another version of it.
+func (i packageImporter) loadedOnly() packageImporter {
+	return packageImporter{loaded: i.loaded}
+}
+
+// loadImporter loads the packages the store package and its mocks import, in one
+// go so they share the types of their common dependencies.
+func loadImporter(t *testing.T) packageImporter {
+	t.Helper()
+	config := &packages.Config{Mode: packages.LoadAllSyntax}
+	loaded, err := packages.Load(config, "context", "github.com/redjolr/goherent/expect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	imp := packageImporter{loaded: map[string]*types.Package{}}
+	for _, pkg := range loaded {
+		imp.loaded[pkg.PkgPath] = pkg.Types
+	}
+	return imp
+}
+
+// typeCheckFiles parses the sources and type-checks them as one package.
+func typeCheckFiles(path string, imp types.Importer, sources ...string) error {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for i, src := range sources {
+		file, err := parser.ParseFile(fset, fmt.Sprintf("file%d.go", i), src, 0)
+		if err != nil {
+			return err
+		}
+		files = append(files, file)
+	}
+	conf := types.Config{Importer: imp}
+	_, err := conf.Check(path, fset, files, nil)
+	return err
+}
+
+const storeSrc = `package store
+
+import "context"
+
+type User struct{ Name string }
+
+type Store interface {
+	Find(ctx context.Context, id string) (User, error)
+	Log(format string, context ...any)
+	Close()
+}
+
+type Constraint interface{ ~int }
+
+type unexported interface{ save() }
+
+type Clashing interface {
+	Do(arg1 int, _ string)
+	Get(impl int, arg0 string)
+}
+`
+
+func TestGenerate(t *testing.T) {
+	imp := loadImporter(t)
+	pkg := typeCheck(t, storeSrc, imp)
+	imp.store = pkg
+
+	t.Run("generates a mock of an interface from another package", func(t *testing.T) {
+		src, err := Generate(pkg, "Store", "storemock")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range []string{
+			"package storemock",
+			"\t\"example.com/app/store\"",
+			"\tFind  *expect.Mock[func(ctx context.Context, id string) (store.User, error)]",
+			"\t\tClose: expect.Fn[func()](),",
+			"func (m *MockStore) Impl() store.Store {",
+			"func (impl mockStoreImpl) Find(ctx context.Context, id string) (store.User, error) {\n\treturn impl.mock.Find.Func()(ctx, id)\n}",
+			"func (impl mockStoreImpl) Close() {\n\timpl.mock.Close.Func()()\n}",
+		} {
+			if !strings.Contains(string(src), want) {
+				t.Errorf("the mock does not contain %q:\n%s", want, src)
+			}
+		}
+	})
+
+	t.Run("renames parameters named like an imported package and spreads variadic ones", func(t *testing.T) {
+		src, err := Generate(pkg, "Store", "storemock")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "func (impl mockStoreImpl) Log(format string, arg1 ...any) {\n\timpl.mock.Log.Func()(format, arg1...)\n}"
+		if !strings.Contains(string(src), want) {
+			t.Errorf("the mock does not contain %q:\n%s", want, src)
+		}
+	})
+
+	t.Run("does not qualify the types of its own package when generated in it", func(t *testing.T) {
+		src, err := Generate(pkg, "Store", "store")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(src), "store.") {
+			t.Errorf("the mock qualifies types of its own package:\n%s", src)
+		}
+	})
+
+	t.Run("generates a mock that compiles next to the package", func(t *testing.T) {
+		src, err := Generate(pkg, "Store", "storemock")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := typeCheckFiles("example.com/app/storemock", imp, string(src)); err != nil {
+			t.Errorf("the mock does not compile: %v\n%s", err, src)
+		}
+	})
+
+	t.Run("renames parameters to names no other parameter has", func(t *testing.T) {
+		src, err := Generate(pkg, "Clashing", "storemock")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range []string{
+			"func (impl mockClashingImpl) Do(arg1 int, arg2 string) {",
+			"func (impl mockClashingImpl) Get(arg1 int, arg0 string) {",
+		} {
+			if !strings.Contains(string(src), want) {
+				t.Errorf("the mock does not contain %q:\n%s", want, src)
+			}
+		}
+		if err := typeCheckFiles("example.com/app/storemock", imp, string(src)); err != nil {
+			t.Errorf("the mock does not compile: %v\n%s", err, src)
+		}
+	})
+
+	t.Run("generates a mock that compiles in the package", func(t *testing.T) {
+		src, err := Generate(pkg, "Store", "store")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := typeCheckFiles("example.com/app/store", imp.loadedOnly(), storeSrc, string(src)); err != nil {
+			t.Errorf("the mock does not compile: %v\n%s", err, src)
+		}
+	})
+
+	errorCases := []struct {
+		name      string
+		ifaceName string
+		outPkg    string
+		wantErr   string
+	}{
+		{"a missing type", "Missing", "storemock", "has no type Missing"},
+		{"a type that is not an interface", "User", "storemock", "is not an interface"},
+		{"a constraint", "Constraint", "storemock", "is a constraint"},
+		{"unexported methods outside the package", "unexported", "storemock", "has the unexported method save"},
+	}
+	for _, c := range errorCases {
+		t.Run("fails for "+c.name, func(t *testing.T) {
+			_, err := Generate(pkg, c.ifaceName, c.outPkg)
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Errorf("got error %v, want one containing %q", err, c.wantErr)
+			}
+		})
+	}
+}
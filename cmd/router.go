@@ -7,6 +7,7 @@ import (
 	"github.com/redjolr/goherent/cmd/concurrent_events"
 	"github.com/redjolr/goherent/cmd/events"
 	"github.com/redjolr/goherent/cmd/sequential_events"
+	"github.com/redjolr/goherent/internal"
 )
 
 type Router struct {
@@ -39,10 +40,10 @@ func (r *Router) Route(jsonEvt events.JsonEvent, concurrently bool) {
 	if jsonEvt.Test == nil && jsonEvt.Action == "skip" {
 		evt = eventsMapper.JsonTestEvt2NoPackTestsFoundEvent(jsonEvt)
 	}
-	if jsonEvt.Test != nil && jsonEvt.Action == "pass" && strings.Contains(*jsonEvt.Test, "/") {
+	if jsonEvt.Test != nil && jsonEvt.Action == "pass" && isCtest(*jsonEvt.Test) {
 		evt = eventsMapper.JsonTestEvt2CtestPassedEvt(jsonEvt)
 	}
-	if jsonEvt.Test != nil && jsonEvt.Action == "run" && strings.Contains(*jsonEvt.Test, "/") {
+	if jsonEvt.Test != nil && jsonEvt.Action == "run" && isCtest(*jsonEvt.Test) {
 		evt = eventsMapper.JsonTestEvt2CtestRanEvt(jsonEvt)
 	}
 	if jsonEvt.Test != nil && jsonEvt.Action == "output" {
@@ -51,7 +52,7 @@ func (r *Router) Route(jsonEvt events.JsonEvent, concurrently bool) {
 	if jsonEvt.Test != nil && jsonEvt.Action == "fail" {
 		evt = eventsMapper.JsonTestEvt2CtestFailedEvt(jsonEvt)
 	}
-	if jsonEvt.Test != nil && jsonEvt.Action == "skip" && strings.Contains(*jsonEvt.Test, "/") {
+	if jsonEvt.Test != nil && jsonEvt.Action == "skip" && isCtest(*jsonEvt.Test) {
 		evt = eventsMapper.JsonTestEvt2CtestSkippedEvt(jsonEvt)
 	}
 
@@ -62,6 +63,13 @@ func (r *Router) Route(jsonEvt events.JsonEvent, concurrently bool) {
 	}
 }
 
+// isCtest reports whether a go test name is one of the client's test cases: a
+// subtest that is not a goherent Describe block, which only groups test cases
+// and is therefore treated like a top-level test function.
+func isCtest(testName string) bool {
+	return strings.Contains(testName, "/") && !internal.IsGoherentDescribeBlock(testName)
+}
+
 // RouteBuildErrors attaches compiler output captured from the runner's stderr to
 // the packages that failed to build, so the user is told why. It must run after
 // all JSON events are processed and before the testing-finished summary is
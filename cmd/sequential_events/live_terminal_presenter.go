@@ -22,6 +22,7 @@ type LiveTerminalPresenter struct {
 	passed       int
 	failed       int
 	skipped      int
+	todo         int
 	runningName  string // raw name of the running test, or "" when none is running
 	spinnerFrame int
 	boxOpen      bool // whether a package "box" is currently open
@@ -106,9 +107,13 @@ func (p *LiveTerminalPresenter) CtestFailed(ctest *ctests_tracker.Ctest, duratio
 }
 
 func (p *LiveTerminalPresenter) CtestSkipped(ctest *ctests_tracker.Ctest) {
-	p.skipped++
+	if ctest.IsTodo() {
+		p.todo++
+	} else {
+		p.skipped++
+	}
 	p.runningName = ""
-	p.region.Render("\n"+p.inBox(testLine("⏩", ctest.Name(), "")), p.liveBlock())
+	p.region.Render("\n"+p.inBox(testLine(skippedIcon(ctest), ctest.Name(), "")), p.liveBlock())
 }
 
 func (p *LiveTerminalPresenter) CtestOutput(ctest *ctests_tracker.Ctest) {
@@ -175,7 +180,7 @@ func (p *LiveTerminalPresenter) spinnerIcon() string {
 }
 
 func (p *LiveTerminalPresenter) footer() string {
-	if p.passed+p.failed+p.skipped == 0 {
+	if p.passed+p.failed+p.skipped+p.todo == 0 {
 		return ""
 	}
 	parts := []string{ansi_escape.GREEN + fmt.Sprintf("%d passed", p.passed) + ansi_escape.COLOR_RESET}
@@ -185,6 +190,9 @@ func (p *LiveTerminalPresenter) footer() string {
 	if p.skipped > 0 {
 		parts = append(parts, ansi_escape.YELLOW+fmt.Sprintf("%d skipped", p.skipped)+ansi_escape.COLOR_RESET)
 	}
+	if p.todo > 0 {
+		parts = append(parts, ansi_escape.MAGENTA+fmt.Sprintf("%d todo", p.todo)+ansi_escape.COLOR_RESET)
+	}
 	return strings.Join(parts, " · ")
 }
 
@@ -259,13 +267,16 @@ func buildFinalSummary(summary ctests_tracker.TestingSummary) string {
 	if summary.SkippedTestsCount > 0 {
 		testsSummary += ansi_escape.YELLOW + fmt.Sprintf("%d skipped", summary.SkippedTestsCount) + ansi_escape.COLOR_RESET + ", "
 	}
+	if summary.TodoTestsCount > 0 {
+		testsSummary += ansi_escape.MAGENTA + fmt.Sprintf("%d todo", summary.TodoTestsCount) + ansi_escape.COLOR_RESET + ", "
+	}
 	if summary.PassedTestsCount > 0 {
 		testsSummary += ansi_escape.GREEN + fmt.Sprintf("%d passed", summary.PassedTestsCount) + ansi_escape.COLOR_RESET + ", "
 	}
 	packagesSummary += fmt.Sprintf("%d total", summary.PackagesCount)
 	testsSummary += fmt.Sprintf("%d total", summary.TestsCount) + passRateLabel(summary)
 
-	return testingVerdictHeadline(summary) + buildFailuresNote(summary) + "\n" +
+	return testingVerdictHeadline(summary) + buildFailuresNote(summary) + focusedTestsNote(summary) + "\n" +
 		packagesSummary + "\n" +
 		testsSummary + "\n" +
 		timeSummary + "\n" +
@@ -108,3 +108,49 @@ func buildFailuresNote(summary ctests_tracker.TestingSummary) string {
 	msg := fmt.Sprintf("⚠ %d %s failed to build; %s tests did not run", n, pkgWord, possessive)
 	return "\n" + ansi_escape.YELLOW + msg + ansi_escape.COLOR_RESET
 }
+
+// focusedTestsNote returns a bold yellow warning line, prefixed with a newline so
+// it sits under the verdict headline, calling out TestOnly tests: while any are
+// present the rest of their packages' tests do not run, so a green verdict must
+// not let them slip into CI unnoticed. Returns "" when no test is focused or
+// skipped by focus.
+func focusedTestsNote(summary ctests_tracker.TestingSummary) string {
+	if summary.FocusedTestsCount == 0 && summary.SkippedByFocusTestsCount == 0 {
+		return ""
+	}
+	if summary.FocusedTestsCount == 0 {
+		// The focused tests were filtered out, by -run for instance, so nothing
+		// but the skipped ones hints that part of the run never happened.
+		skippedWord := "tests were"
+		if summary.SkippedByFocusTestsCount == 1 {
+			skippedWord = "test was"
+		}
+		msg := fmt.Sprintf(
+			"⚠ %d %s skipped by focus, but no TestOnly test ran. Remove TestOnly before committing.",
+			summary.SkippedByFocusTestsCount, skippedWord,
+		)
+		return "\n" + ansi_escape.BOLD + ansi_escape.YELLOW + msg + ansi_escape.COLOR_RESET
+	}
+	focusedWord := "test is"
+	if summary.FocusedTestsCount > 1 {
+		focusedWord = "tests are"
+	}
+	skippedWord := "tests were"
+	if summary.SkippedByFocusTestsCount == 1 {
+		skippedWord = "test was"
+	}
+	msg := fmt.Sprintf(
+		"⚠ %d %s focused with TestOnly; %d other %s skipped. Remove TestOnly before committing.",
+		summary.FocusedTestsCount, focusedWord, summary.SkippedByFocusTestsCount, skippedWord,
+	)
+	return "\n" + ansi_escape.BOLD + ansi_escape.YELLOW + msg + ansi_escape.COLOR_RESET
+}
+
+// skippedIcon is the result icon of a skipped test: a TestTodo placeholder gets
+// its own, so it is not mistaken for a parked test.
+func skippedIcon(ctest *ctests_tracker.Ctest) string {
+	if ctest.IsTodo() {
+		return "📝"
+	}
+	return "⏩"
+}
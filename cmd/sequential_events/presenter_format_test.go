@@ -41,3 +41,24 @@ func TestBuildFailuresNote(t *testing.T) {
 		t.Errorf("unexpected plural note: %q", two)
 	}
 }
+
+func TestFocusedTestsNote(t *testing.T) {
+	if got := focusedTestsNote(ctests_tracker.TestingSummary{}); got != "" {
+		t.Errorf("expected no note when no test is focused, got %q", got)
+	}
+
+	one := focusedTestsNote(ctests_tracker.TestingSummary{FocusedTestsCount: 1, SkippedByFocusTestsCount: 1})
+	if !strings.Contains(one, "1 test is focused with TestOnly") || !strings.Contains(one, "1 other test was skipped") {
+		t.Errorf("unexpected single-test note: %q", one)
+	}
+
+	many := focusedTestsNote(ctests_tracker.TestingSummary{FocusedTestsCount: 2, SkippedByFocusTestsCount: 5})
+	if !strings.Contains(many, "2 tests are focused with TestOnly") || !strings.Contains(many, "5 other tests were skipped") {
+		t.Errorf("unexpected plural note: %q", many)
+	}
+
+	unfocused := focusedTestsNote(ctests_tracker.TestingSummary{SkippedByFocusTestsCount: 3})
+	if !strings.Contains(unfocused, "3 tests were skipped by focus, but no TestOnly test ran") {
+		t.Errorf("unexpected note without focused tests: %q", unfocused)
+	}
+}
@@ -43,7 +43,7 @@ func (tp UnboundedTerminalPresenter) CtestFailed(ctest *ctests_tracker.Ctest, du
 
 func (tp UnboundedTerminalPresenter) CtestSkipped(ctest *ctests_tracker.Ctest) {
 	tp.terminal.MoveLeft(utils.DisplayWidth("⏳"))
-	tp.terminal.Print("⏩\n")
+	tp.terminal.Print(skippedIcon(ctest) + "\n")
 }
 
 func (tp UnboundedTerminalPresenter) CtestOutput(ctest *ctests_tracker.Ctest) {
@@ -120,6 +120,11 @@ func (tp UnboundedTerminalPresenter) TestingFinishedSummary(summary ctests_track
 			fmt.Sprintf("%d skipped", summary.SkippedTestsCount) +
 			ansi_escape.COLOR_RESET + ", "
 	}
+	if summary.TodoTestsCount > 0 {
+		testsSummary += ansi_escape.MAGENTA +
+			fmt.Sprintf("%d todo", summary.TodoTestsCount) +
+			ansi_escape.COLOR_RESET + ", "
+	}
 	if summary.PassedTestsCount > 0 {
 		testsSummary += ansi_escape.GREEN +
 			fmt.Sprintf("%d passed", summary.PassedTestsCount) +
@@ -128,7 +133,7 @@ func (tp UnboundedTerminalPresenter) TestingFinishedSummary(summary ctests_track
 	packagesSummary += fmt.Sprintf("%d total", summary.PackagesCount)
 	testsSummary += fmt.Sprintf("%d total", summary.TestsCount) + passRateLabel(summary)
 
-	tp.terminal.Print("\n" + testingVerdictHeadline(summary) + buildFailuresNote(summary) + "\n")
+	tp.terminal.Print("\n" + testingVerdictHeadline(summary) + buildFailuresNote(summary) + focusedTestsNote(summary) + "\n")
 	tp.terminal.Print(
 		packagesSummary + "\n" +
 			testsSummary + "\n" +
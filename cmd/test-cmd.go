@@ -3,14 +3,18 @@ package cmd
 import (
 	"bufio"
 	"io"
+	"os"
 	"os/exec"
 	"slices"
 	"time"
+
+	"github.com/redjolr/goherent/internal"
 )
 
 type TestCmd struct {
 	staticArgs []string
 	args       []string
+	env        []string
 
 	cmd     *exec.Cmd
 	scanner *bufio.Scanner
@@ -42,6 +46,9 @@ func (t *TestCmd) RunsTestsConcurrently() bool {
 
 func (t *TestCmd) Exec() *TestCmd {
 	t.cmd = exec.Command("go", slices.Concat(t.staticArgs, t.args)...)
+	if len(t.env) > 0 {
+		t.cmd.Env = append(os.Environ(), t.env...)
+	}
 	t.hasStarted = true
 	t.startTime = time.Now()
 
@@ -112,6 +119,12 @@ func (t *TestCmd) NonVerbose() *TestCmd {
 	return t
 }
 
+// Colored makes the tests colour the diffs of their failed expectations.
+func (t *TestCmd) Colored() *TestCmd {
+	t.env = append(t.env, internal.COLOR_ENV+"=1")
+	return t
+}
+
 func (t *TestCmd) ExitCode() int {
 	return t.cmd.ProcessState.ExitCode()
 }
@@ -0,0 +1,101 @@
+package expect
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/redjolr/goherent/expect/internal"
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+// The placeholders below stand for a value that is not known exactly. They can
+// be passed to ToEqual, ToContainElement, ToMatchObject and ToHaveBeenCalledWith,
+// or embedded at any depth inside the value passed to them, where they match the
+// value at the same place rather than being compared with it. A placeholder can
+// only be embedded where the value may hold any type: in a []any, a map[K]any or
+// a field of type any.
+//
+//	Expect(response).ToEqual(map[string]any{
+//		"id":    expect.Any[int](),
+//		"name":  expect.StringContaining("ada"),
+//		"score": expect.CloseTo(0.3, 1e-9),
+//	})
+
+// Any matches any non-nil value of type T or, if T is an interface, any value
+// implementing it.
+//
+//	expect.Any[int]()
+//	expect.Any[error]()
+func Any[T any]() any {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	return internal.NewAsymmetricMatcher(fmt.Sprintf("Any[%s]", typ), func(actual any) bool {
+		if actual == nil {
+			return false
+		}
+		if typ.Kind() == reflect.Interface {
+			return reflect.TypeOf(actual).Implements(typ)
+		}
+		return reflect.TypeOf(actual) == typ
+	})
+}
+
+// Anything matches any value but nil.
+func Anything() any {
+	return internal.NewAsymmetricMatcher("Anything", func(actual any) bool {
+		return actual != nil
+	})
+}
+
+// StringContaining matches a string containing substring.
+func StringContaining(substring string) any {
+	return internal.NewAsymmetricMatcher(fmt.Sprintf("StringContaining(%q)", substring), func(actual any) bool {
+		s, ok := actual.(string)
+		return ok && strings.Contains(s, substring)
+	})
+}
+
+// StringMatching matches a string matching the regular expression pattern. It
+// panics if pattern does not compile.
+func StringMatching(pattern string) any {
+	re := regexp.MustCompile(pattern)
+	return internal.NewAsymmetricMatcher(fmt.Sprintf("StringMatching(%q)", pattern), func(actual any) bool {
+		s, ok := actual.(string)
+		return ok && re.MatchString(s)
+	})
+}
+
+// ArrayContaining matches a slice or an array containing all of elements, in any
+// order, and possibly others. The elements may be placeholders themselves.
+func ArrayContaining(elements ...any) any {
+	description := "ArrayContaining("
+	for i, element := range elements {
+		if i > 0 {
+			description += ", "
+		}
+		description += fmt.Sprintf("%#v", element)
+	}
+	description += ")"
+
+	return internal.NewAsymmetricMatcher(description, func(actual any) bool {
+		value := reflect.ValueOf(actual)
+		if actual == nil || (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) {
+			return false
+		}
+		for _, element := range elements {
+			if assertions.ToContainElement(actual, element) != nil {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// CloseTo matches a number within tolerance (inclusive) of target, as
+// ToBeCloseTo does.
+func CloseTo(target, tolerance float64) any {
+	return internal.NewAsymmetricMatcher(fmt.Sprintf("CloseTo(%v, %v)", target, tolerance), func(actual any) bool {
+		return assertions.ToBeCloseTo(actual, target, tolerance) == nil
+	})
+}
@@ -0,0 +1,99 @@
+package expect
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+type event struct {
+	ID      any
+	Name    string
+	Payload map[string]any
+	tags    []any
+}
+
+func TestAsymmetricMatchers(t *testing.T) {
+	var tests = []struct {
+		name           string
+		assertion      func() error
+		assertionFails bool
+	}{
+		{"Any matching a value of its type", func() error { return assertions.ToEqual(3, Any[int]()) }, false},
+		{"Any not matching a value of another type", func() error { return assertions.ToEqual("3", Any[int]()) }, true},
+		{"Any of an interface matching an implementation", func() error { return assertions.ToEqual(assertions.ToBeTrue(false), Any[error]()) }, false},
+		{"Any not matching nil", func() error { return assertions.ToEqual(nil, Any[error]()) }, true},
+		{"Anything matching a value", func() error { return assertions.ToEqual([]any{0}, []any{Anything()}) }, false},
+		{"Anything not matching nil", func() error { return assertions.ToEqual([]any{nil}, []any{Anything()}) }, true},
+		{"StringContaining matching", func() error { return assertions.ToEqual("hello world", StringContaining("lo w")) }, false},
+		{"StringContaining not matching", func() error { return assertions.ToEqual("hello world", StringContaining("bye")) }, true},
+		{"StringMatching matching", func() error { return assertions.ToEqual("id-42", StringMatching(`^id-\d+$`)) }, false},
+		{"StringMatching not matching", func() error { return assertions.ToEqual("id-x", StringMatching(`^id-\d+$`)) }, true},
+		{"ArrayContaining matching", func() error { return assertions.ToEqual([]int{1, 2, 3}, ArrayContaining(3, 1)) }, false},
+		{"ArrayContaining not matching", func() error { return assertions.ToEqual([]int{1, 2, 3}, ArrayContaining(4)) }, true},
+		{"CloseTo matching", func() error { return assertions.ToEqual(0.1+0.2, CloseTo(0.3, 1e-9)) }, false},
+		{"CloseTo not matching", func() error { return assertions.ToEqual(0.31, CloseTo(0.3, 1e-9)) }, true},
+		{"placeholders nested in maps and slices", func() error {
+			return assertions.ToEqual(
+				map[string]any{"id": 7, "tags": []any{"a", "b"}},
+				map[string]any{"id": Any[int](), "tags": []any{"a", Anything()}},
+			)
+		}, false},
+		{"placeholders in struct fields, unexported ones included", func() error {
+			return assertions.ToEqual(
+				event{ID: 7, Name: "created", Payload: map[string]any{"at": 1.5}, tags: []any{"x"}},
+				event{ID: Any[int](), Name: "created", Payload: map[string]any{"at": CloseTo(1.5, 0.1)}, tags: []any{StringContaining("x")}},
+			)
+		}, false},
+		{"a nested placeholder not matching", func() error {
+			return assertions.ToEqual(event{ID: "7", Name: "created"}, event{ID: Any[int](), Name: "created"})
+		}, true},
+		{"a difference next to a matching placeholder", func() error {
+			return assertions.ToEqual(event{ID: 7, Name: "created"}, event{ID: Any[int](), Name: "deleted"})
+		}, true},
+		{"ToContainElement with a placeholder", func() error {
+			return assertions.ToContainElement([]string{"apple", "banana"}, StringContaining("nan"))
+		}, false},
+		{"ToContainElement with a placeholder not matching", func() error {
+			return assertions.ToContainElement([]string{"apple", "banana"}, StringContaining("kiwi"))
+		}, true},
+		{"ToMatchObject with a placeholder", func() error {
+			return assertions.ToMatchObject(event{ID: 7, Name: "created"}, map[string]any{"ID": Any[int]()})
+		}, false},
+		{"ToMatchObject with a placeholder not matching", func() error {
+			return assertions.ToMatchObject(event{ID: 7, Name: "created"}, map[string]any{"Name": StringMatching("^del")})
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.assertion()
+			if tt.assertionFails && err == nil {
+				t.Error("expected the assertion to fail, but it passed")
+			}
+			if !tt.assertionFails && err != nil {
+				t.Errorf("expected the assertion to pass, but it failed with: %v", err)
+			}
+		})
+	}
+}
+
+// TestAsymmetricMatchersDiff checks that the diff doesn't flag a matching
+// placeholder, only the real difference.
+func TestAsymmetricMatchersDiff(t *testing.T) {
+	err := assertions.ToEqual(
+		[]any{7, "created", "x"},
+		[]any{Any[int](), "deleted", "x"},
+	)
+	if err == nil {
+		t.Fatal("expected the assertion to fail, but it passed")
+	}
+	message := err.Error()
+	diff := message[strings.Index(message, "Diff:"):]
+	expectedDiff := "Diff:\n" +
+		"  [1]: expected \"created\", actual \"deleted\"\n"
+	if diff != expectedDiff {
+		t.Errorf("The diff does not have the expected format.\n\nShould be:\n%s\n\nIs:\n%s", expectedDiff, diff)
+	}
+}
@@ -0,0 +1,66 @@
+package expect
+
+import (
+	"reflect"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// EqualOption adjusts how ToEqual compares two values.
+type EqualOption func(options *internal.EqualOptions)
+
+// IgnoreFields leaves the named struct fields out of the comparison. A name alone
+// ignores the field in any struct; a path from the compared value, as in
+// "Order.UpdatedAt", ignores only that one. Slices and maps don't add to the path.
+//
+//	Expect(got).ToEqual(want, expect.IgnoreFields("ID", "CreatedAt"))
+func IgnoreFields(names ...string) EqualOption {
+	return func(options *internal.EqualOptions) {
+		options.IgnoredFields = append(options.IgnoredFields, names...)
+	}
+}
+
+// IgnoreUnexported leaves the unexported struct fields out of the comparison.
+func IgnoreUnexported() EqualOption {
+	return func(options *internal.EqualOptions) {
+		options.IgnoreUnexported = true
+	}
+}
+
+// EquateApprox makes floats equal when they are at most margin apart.
+//
+//	Expect(point).ToEqual(Point{X: 0.3}, expect.EquateApprox(1e-9))
+func EquateApprox(margin float64) EqualOption {
+	return func(options *internal.EqualOptions) {
+		options.Margin = margin
+	}
+}
+
+// SortSlices sorts the slices of T by less before they are compared, so the
+// order of their elements doesn't matter.
+//
+//	Expect(ids).ToEqual([]int{1, 2, 3}, expect.SortSlices(func(a, b int) bool { return a < b }))
+func SortSlices[T any](less func(a, b T) bool) EqualOption {
+	return func(options *internal.EqualOptions) {
+		options.SortSlices = append(options.SortSlices, reflect.ValueOf(less))
+	}
+}
+
+// RegisterComparer makes ToEqual, and the other matchers comparing values,
+// compare the values of T with equal wherever they are found, instead of field by
+// field. Register comparers once, e.g. in TestMain or an init function.
+//
+//	expect.RegisterComparer(time.Time.Equal)
+func RegisterComparer[T any](equal func(a, b T) bool) {
+	internal.RegisterComparer(reflect.TypeOf((*T)(nil)).Elem(), func(a, b any) bool {
+		return equal(a.(T), b.(T))
+	})
+}
+
+func equalOptions(options []EqualOption) internal.EqualOptions {
+	var equalOptions internal.EqualOptions
+	for _, option := range options {
+		option(&equalOptions)
+	}
+	return equalOptions
+}
@@ -0,0 +1,78 @@
+package expect
+
+import (
+	"testing"
+	"time"
+)
+
+type order struct {
+	ID        int
+	Total     float64
+	Items     []string
+	UpdatedAt time.Time
+	Customer  customer
+	revision  int
+}
+
+type customer struct {
+	Name      string
+	UpdatedAt time.Time
+}
+
+// instant is compared by the comparer registered in TestEqualOptions.
+type instant struct {
+	at time.Time
+}
+
+func TestEqualOptions(t *testing.T) {
+	RegisterComparer(func(a, b instant) bool { return a.at.Equal(b.at) })
+
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	want := order{ID: 1, Total: 0.3, Items: []string{"a", "b"}, UpdatedAt: now, Customer: customer{"ada", now}, revision: 1}
+	later := want
+	later.UpdatedAt = now.Add(time.Hour)
+	laterCustomer := want
+	laterCustomer.Customer.UpdatedAt = now.Add(time.Hour)
+	revised := want
+	revised.revision = 2
+	approx := want
+	approx.Total = 0.3 + 1e-12
+	reordered := want
+	reordered.Items = []string{"b", "a"}
+	byString := func(a, b string) bool { return a < b }
+
+	cases := []struct {
+		name       string
+		value      any
+		expected   any
+		options    []EqualOption
+		wantFailed bool
+	}{
+		{"an ignored field that differs", later, want, []EqualOption{IgnoreFields("UpdatedAt")}, false},
+		{"a field ignored by name in a nested struct", laterCustomer, want, []EqualOption{IgnoreFields("UpdatedAt")}, false},
+		{"a field ignored by its path", laterCustomer, want, []EqualOption{IgnoreFields("Customer.UpdatedAt")}, false},
+		{"a field ignored by another path", laterCustomer, want, []EqualOption{IgnoreFields("UpdatedAt.Customer")}, true},
+		{"a differing field that is not ignored", later, want, []EqualOption{IgnoreFields("Total")}, true},
+		{"ignored unexported fields", revised, want, []EqualOption{IgnoreUnexported()}, false},
+		{"unexported fields that differ", revised, want, nil, true},
+		{"floats within the margin", approx, want, []EqualOption{EquateApprox(1e-9)}, false},
+		{"floats without a margin", approx, want, nil, true},
+		{"floats outside the margin", 0.31, 0.3, []EqualOption{EquateApprox(1e-9)}, true},
+		{"sorted slices", reordered, want, []EqualOption{SortSlices(byString)}, false},
+		{"slices in another order", reordered, want, nil, true},
+		{"sorted slices with different elements", []string{"b", "c"}, []string{"a", "b"}, []EqualOption{SortSlices(byString)}, true},
+		{"a registered comparer", instant{now.In(time.Local)}, instant{now}, nil, false},
+		{"a registered comparer in a slice", []instant{{now.In(time.Local)}}, []instant{{now}}, nil, false},
+		{"a registered comparer not equating", instant{now.Add(time.Second)}, instant{now}, nil, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e, spy := newExpectation(c.value)
+			e.ToEqual(c.expected, c.options...)
+			if spy.failed != c.wantFailed {
+				t.Errorf("failed = %v, want %v\n%s", spy.failed, c.wantFailed, spy.output.String())
+			}
+		})
+	}
+}
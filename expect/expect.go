@@ -2,7 +2,7 @@ package expect
 
 import "testing"
 
-func expect(t *testing.T) func(val any) *expectation {
+func expect(t testing.TB) func(val any) *expectation {
 	return func(value any) *expectation {
 		theExpectation := expectation{
 			t:                       t,
@@ -14,6 +14,8 @@ func expect(t *testing.T) func(val any) *expectation {
 
 type F func(val any) *expectation
 
-func New(t *testing.T) func(value any) *expectation {
+// New returns the Expect function for t. Any testing.TB is accepted, so the same
+// assertions work in tests, benchmarks and wrappers that embed a testing.TB.
+func New(t testing.TB) func(value any) *expectation {
 	return expect(t)
 }
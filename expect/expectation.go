@@ -2,25 +2,33 @@ package expect
 
 import (
 	"fmt"
+	"regexp"
 	"runtime"
 	"strings"
+	"time"
 
+	"github.com/redjolr/goherent/expect/internal"
 	"github.com/redjolr/goherent/expect/internal/assertions"
 	"github.com/redjolr/goherent/internal/utils"
 	"github.com/redjolr/goherent/terminal/ansi_escape"
 )
 
 // tFailer is the slice of *testing.T that an expectation needs: a way to mark the
-// test as failed. Storing the dependency as an interface (rather than *testing.T)
-// lets the negation logic be unit-tested with a spy.
+// test as failed, and to stop it for a Must expectation. Storing the dependency
+// as an interface (rather than *testing.T) lets the negation logic be unit-tested
+// with a spy.
 type tFailer interface {
 	Fail()
+	FailNow()
 }
 
 type expectation struct {
 	t                       tFailer
 	checkExpectationAgainst any
 	negated                 bool
+	must                    bool
+	message                 string
+	polling                 *polling
 }
 
 // Not returns an expectation whose matchers assert the inverse: the test fails
@@ -31,150 +39,615 @@ type expectation struct {
 //	Expect(x).Not().ToEqual(y)
 //	Expect(m).Not().ToHaveKey("k")
 func (e *expectation) Not() *expectation {
-	return &expectation{
-		t:                       e.t,
-		checkExpectationAgainst: e.checkExpectationAgainst,
-		negated:                 !e.negated,
-	}
+	negated := *e
+	negated.negated = !e.negated
+	return &negated
+}
+
+// Must returns a hard expectation: when its matcher fails, the test stops right
+// there (t.FailNow) instead of carrying on to lines that depend on it, such as a
+// dereference of the value just expected not to be nil. It combines with Not in
+// either order.
+//
+//	Expect(user).Must().Not().ToBeNil()
+//	Expect(user.Name).ToEqual("Ada")
+func (e *expectation) Must() *expectation {
+	must := *e
+	must.must = true
+	return &must
+}
+
+// WithMessage returns an expectation whose failure output starts with the given
+// message, formatted as with fmt.Sprintf. It tells apart the failures of one
+// expectation run in a loop or for each row of a table.
+//
+//	for _, user := range users {
+//		Expect(user.Active).WithMessage("user %s should be active", user.ID).ToBeTrue()
+//	}
+func (e *expectation) WithMessage(format string, args ...any) *expectation {
+	withMessage := *e
+	withMessage.message = fmt.Sprintf(format, args...)
+	return &withMessage
+}
+
+// Eventually returns an expectation on a producer, a function with no arguments
+// and one result passed to Expect. Its matcher passes as soon as a value returned
+// by the producer satisfies it; the producer is called every interval until then,
+// and the matcher fails once timeout has elapsed, reporting the last value and
+// the number of attempts.
+//
+//	Expect(func() any { return worker.Processed() }).Eventually(time.Second, 10*time.Millisecond).ToEqual(3)
+func (e *expectation) Eventually(timeout, interval time.Duration) *expectation {
+	eventually := *e
+	eventually.polling = &polling{timeout: timeout, interval: interval}
+	return &eventually
+}
+
+// Consistently is the counterpart of Eventually: its matcher passes only if every
+// value the producer returns, every interval until timeout has elapsed, satisfies
+// it, and fails at the first value that doesn't.
+//
+//	Expect(func() any { return len(queue) }).Consistently(100*time.Millisecond, 10*time.Millisecond).ToEqual(0)
+func (e *expectation) Consistently(timeout, interval time.Duration) *expectation {
+	consistently := *e
+	consistently.polling = &polling{timeout: timeout, interval: interval, consistently: true}
+	return &consistently
 }
 
-// report applies the (possibly negated) outcome of a matcher. err is the matcher
-// assertion's result (nil == satisfied). matcher is a short description of the
-// matcher (e.g. `equal 3`) used only to phrase the message when a negated matcher
-// unexpectedly held.
-func (e *expectation) report(matcher string, err error) {
-	satisfied := err == nil
-	// Fail when the outcome doesn't match what was expected: a positive matcher
-	// that wasn't satisfied, or a negated matcher that was.
-	if e.negated != satisfied {
+// report applies the (possibly negated) outcome of a matcher. assert is the
+// matcher's assertion: it checks a value and returns nil when it is satisfied.
+// matcher is a short description of the matcher (e.g. `equal 3`) used only to
+// phrase the message when a negated matcher unexpectedly held. The value checked
+// is the one given to Expect or, for Eventually and Consistently, each value its
+// producer returns.
+func (e *expectation) report(matcher string, assert func(value any) error) {
+	failure := func(value any) string {
+		err := assert(value)
+		// Fail when the outcome doesn't match what was expected: a positive matcher
+		// that wasn't satisfied, or a negated matcher that was.
+		if e.negated != (err == nil) {
+			return ""
+		}
+		if e.negated {
+			return fmt.Sprintf("expected %s not to %s", internal.FormatValue(value), matcher)
+		}
+		return err.Error()
+	}
+	var explanation string
+	if e.polling != nil {
+		explanation = e.polling.run(e.checkExpectationAgainst, failure)
+	} else {
+		explanation = failure(e.checkExpectationAgainst)
+	}
+	if explanation == "" {
 		return
 	}
 	file, line := callerOutsideExpectation()
-	e.print(fmt.Sprintf(ansi_escape.YELLOW+"%s:%d"+ansi_escape.COLOR_RESET, file, line), 4)
-	if e.negated {
-		e.print(fmt.Sprintf("expected %#v not to %s", e.checkExpectationAgainst, matcher), 6)
-	} else {
-		e.print(err.Error(), 6)
+	message := indent(fmt.Sprintf(ansi_escape.YELLOW+"%s:%d"+ansi_escape.COLOR_RESET, file, line), 4)
+	if e.message != "" {
+		message += indent(e.message, 6)
+	}
+	message += indent(explanation, 6)
+	// The failure is printed as one block, so it stays in one piece and under the
+	// right test when parallel tests interleave.
+	utils.PrintToTest(e.t, message)
+	if e.must {
+		e.t.FailNow()
+		return
 	}
 	e.t.Fail()
 }
 
 // callerOutsideExpectation walks up the stack to the first frame that is not in
-// this file, so the reported location is the test's call site regardless of how
-// many internal wrappers (Not, report, a Not* alias) sit in between.
+// this file or in that.go, so the reported location is the test's call site
+// regardless of how many internal wrappers (Not, report, a Not* alias, a typed
+// matcher of That) sit in between.
 func callerOutsideExpectation() (string, int) {
 	for skip := 1; skip < 32; skip++ {
 		_, file, line, ok := runtime.Caller(skip)
 		if !ok {
 			break
 		}
-		if !strings.HasSuffix(file, "/expect/expectation.go") {
+		if !strings.HasSuffix(file, "/expect/expectation.go") && !strings.HasSuffix(file, "/expect/that.go") {
 			return file, line
 		}
 	}
 	return "", 0
 }
 
-func (e *expectation) print(str string, leftPadWhitespace int) {
-	lines := utils.SplitStringByNewLine(str)
-	for _, line := range lines {
-		fmt.Println(strings.Repeat(" ", leftPadWhitespace) + line)
+// indent left-pads every line of str and ends each with a newline.
+func indent(str string, leftPadWhitespace int) string {
+	indented := ""
+	for _, line := range utils.SplitStringByNewLine(str) {
+		indented += strings.Repeat(" ", leftPadWhitespace) + line + "\n"
+	}
+	return indented
+}
+
+// To runs a custom matcher, built with NewMatcher, against the value.
+func (e *expectation) To(matcher Matcher) {
+	e.report(matcher.description, matcher.match)
+}
+
+// ToEqual expects the value to be deeply equal to the given one. Options adjust
+// the comparison, e.g. to ignore some fields.
+//
+//	Expect(order).ToEqual(want, expect.IgnoreFields("UpdatedAt"))
+func (e *expectation) ToEqual(expected any, options ...EqualOption) {
+	equalOptions := equalOptions(options)
+	e.report(fmt.Sprintf("equal %#v", expected), func(value any) error {
+		return assertions.ToEqualWith(expected, value, equalOptions)
+	})
+}
+
+// ToEqualJSON expects the value to be a JSON document equivalent to expected,
+// whatever their key order and whitespace. Both are JSON strings or []byte, or
+// values that are marshalled to JSON first. A mismatch is shown as a diff by
+// path.
+//
+//	Expect(body).ToEqualJSON(`{"user": {"name": "ada", "tags": ["math"]}}`)
+func (e *expectation) ToEqualJSON(expected any) {
+	e.report("equal the JSON document "+documentString(expected), func(value any) error {
+		return assertions.ToEqualJSON(value, expected)
+	})
+}
+
+// ToEqualYAML is ToEqualJSON for YAML documents.
+func (e *expectation) ToEqualYAML(expected any) {
+	e.report("equal the YAML document "+documentString(expected), func(value any) error {
+		return assertions.ToEqualYAML(value, expected)
+	})
+}
+
+// ToEqualXML is ToEqualJSON for XML documents. The order of attributes doesn't
+// matter, nor do comments and the whitespace around elements and text.
+func (e *expectation) ToEqualXML(expected any) {
+	e.report("equal the XML document "+documentString(expected), func(value any) error {
+		return assertions.ToEqualXML(value, expected)
+	})
+}
+
+// documentString returns a document given as text as is, and any other value
+// formatted as Go syntax.
+func documentString(document any) string {
+	switch d := document.(type) {
+	case string:
+		return d
+	case []byte:
+		return string(d)
 	}
+	return fmt.Sprintf("%#v", document)
 }
 
-func (e *expectation) ToEqual(actual any) {
-	e.report(fmt.Sprintf("equal %#v", actual), assertions.ToEqual(e.checkExpectationAgainst, actual))
+// ToMatchObject expects a struct or map to contain at least the fields or keys of
+// subset, with equal values, matching nested structs, maps and slices of subset
+// the same way. Subset is a map, or a struct whose zero-valued fields are
+// ignored.
+//
+//	Expect(resp).ToMatchObject(map[string]any{"Status": "ok", "User": map[string]any{"Name": "ada"}})
+func (e *expectation) ToMatchObject(subset any) {
+	e.report(fmt.Sprintf("match the object %#v", subset), func(value any) error {
+		return assertions.ToMatchObject(value, subset)
+	})
 }
 
 func (e *expectation) ToContain(containee any) {
-	e.report(fmt.Sprintf("contain %#v", containee), assertions.ToContain(e.checkExpectationAgainst, containee))
+	e.report(fmt.Sprintf("contain %#v", containee), func(value any) error {
+		return assertions.ToContain(value, containee)
+	})
 }
 
 func (e *expectation) ToContainElement(element any) {
-	e.report(fmt.Sprintf("contain element %#v", element), assertions.ToContainElement(e.checkExpectationAgainst, element))
+	e.report(fmt.Sprintf("contain element %#v", element), func(value any) error {
+		return assertions.ToContainElement(value, element)
+	})
+}
+
+// ToContainAll expects a collection to contain all the given elements, in any
+// order and among others. An element given twice must be found twice.
+//
+//	Expect(tags).ToContainAll("go", "testing")
+func (e *expectation) ToContainAll(elements ...any) {
+	e.report(fmt.Sprintf("contain all of %#v", elements), func(value any) error {
+		return assertions.ToContainAll(value, elements...)
+	})
+}
+
+// ToContainExactlyInAnyOrder expects a collection to contain the given elements
+// and nothing else, in any order. A failure lists the missing elements and the
+// unexpected ones apart.
+//
+//	Expect(userIDs).ToContainExactlyInAnyOrder(3, 1, 2)
+func (e *expectation) ToContainExactlyInAnyOrder(elements ...any) {
+	e.report(fmt.Sprintf("contain exactly %#v in any order", elements), func(value any) error {
+		return assertions.ToContainExactlyInAnyOrder(value, elements...)
+	})
+}
+
+// ToAllSatisfy expects every element of a collection to satisfy predicate: a
+// func(T) bool, a func(T) error or a Matcher. A failure lists the elements that
+// don't, with their index.
+//
+//	Expect(prices).ToAllSatisfy(func(p float64) bool { return p > 0 })
+func (e *expectation) ToAllSatisfy(predicate any) {
+	predicate = asPredicate(predicate)
+	e.report("all satisfy the predicate", func(value any) error {
+		return assertions.ToAllSatisfy(value, predicate)
+	})
+}
+
+// ToHaveSomeSatisfying expects at least one element of a collection to satisfy
+// predicate: a func(T) bool, a func(T) error or a Matcher.
+func (e *expectation) ToHaveSomeSatisfying(predicate any) {
+	predicate = asPredicate(predicate)
+	e.report("have some element satisfying the predicate", func(value any) error {
+		return assertions.ToHaveSomeSatisfying(value, predicate)
+	})
+}
+
+// ToBeSortedBy expects the elements of a slice, array or iterator to be sorted
+// by less, a func(a, b T) bool reporting whether a goes before b. Equal
+// neighbours are sorted.
+//
+//	Expect(events).ToBeSortedBy(func(a, b Event) bool { return a.At.Before(b.At) })
+func (e *expectation) ToBeSortedBy(less any) {
+	e.report("be sorted", func(value any) error {
+		return assertions.ToBeSortedBy(value, less)
+	})
+}
+
+// ToHaveUniqueElements expects no two elements of a collection to be equal.
+func (e *expectation) ToHaveUniqueElements() {
+	e.report("have unique elements", assertions.ToHaveUniqueElements)
+}
+
+// asPredicate returns the predicate an element is checked against: the match
+// function of a Matcher, or the predicate as it is.
+func asPredicate(predicate any) any {
+	if matcher, ok := predicate.(Matcher); ok {
+		return matcher.match
+	}
+	return predicate
 }
 
 func (e *expectation) ToHaveKey(key any) {
-	e.report(fmt.Sprintf("have key %#v", key), assertions.ToHaveKey(e.checkExpectationAgainst, key))
+	e.report(fmt.Sprintf("have key %#v", key), func(value any) error {
+		return assertions.ToHaveKey(value, key)
+	})
 }
 
 func (e *expectation) ToMatch(pattern string) {
-	e.report(fmt.Sprintf("match pattern %q", pattern), assertions.ToMatch(e.checkExpectationAgainst, pattern))
+	e.report(fmt.Sprintf("match pattern %q", pattern), func(value any) error {
+		return assertions.ToMatch(value, pattern)
+	})
 }
 
 func (e *expectation) ToPanic() {
-	e.report("panic", assertions.ToPanic(e.checkExpectationAgainst))
+	e.report("panic", assertions.ToPanic)
+}
+
+// ToPanicWith expects the value to be a no-argument function that panics with
+// the given value. An error also matches a panic with an error wrapping it.
+//
+//	Expect(func() { mustPositive(-1) }).ToPanicWith(ErrNegative)
+func (e *expectation) ToPanicWith(value any) {
+	e.report(fmt.Sprintf("panic with %s", internal.FormatValue(value)), func(fn any) error {
+		return assertions.ToPanicWith(fn, value)
+	})
+}
+
+// ToPanicMatching expects the value to be a no-argument function that panics
+// with a value, such as a string or an error, whose message matches the regular
+// expression pattern.
+//
+//	Expect(func() { _ = items[5] }).ToPanicMatching(`index out of range`)
+func (e *expectation) ToPanicMatching(pattern string) {
+	e.report(fmt.Sprintf("panic with a value matching %q", pattern), func(fn any) error {
+		return assertions.ToPanicMatching(fn, pattern)
+	})
+}
+
+// ToReturn expects the value to be a no-argument function that returns the
+// given values. For a function whose last result is an error, the error can be
+// left out: it must then be nil.
+//
+//	Expect(func() (int, error) { return strconv.Atoi("42") }).ToReturn(42)
+func (e *expectation) ToReturn(values ...any) {
+	e.report(fmt.Sprintf("return %s", formatReturned(values)), func(fn any) error {
+		return assertions.ToReturn(fn, values...)
+	})
+}
+
+// ToReturnError expects the value to be a no-argument function that returns a
+// non-nil error as its last result. The error can be checked further against
+// an error it must match (errors.Is), or a substring or *regexp.Regexp for its
+// message.
+//
+//	Expect(func() (int, error) { return strconv.Atoi("x") }).ToReturnError(strconv.ErrSyntax)
+func (e *expectation) ToReturnError(expected ...any) {
+	description := "return an error"
+	if len(expected) > 0 {
+		description = fmt.Sprintf("return an error matching %s", formatReturned(expected))
+	}
+	e.report(description, func(fn any) error {
+		return assertions.ToReturnError(fn, expected...)
+	})
+}
+
+// ToCompleteWithin expects the value to be a func() or a func(context.Context)
+// that returns within timeout. A func(context.Context) is passed a context that
+// is done at the deadline. A failure tells how long the function took.
+//
+//	Expect(func(ctx context.Context) { server.Shutdown(ctx) }).ToCompleteWithin(time.Second)
+func (e *expectation) ToCompleteWithin(timeout time.Duration) {
+	e.report(fmt.Sprintf("complete within %s", timeout), func(fn any) error {
+		return assertions.ToCompleteWithin(fn, timeout)
+	})
+}
+
+// ToAllocateAtMost expects the value to be a func() that allocates at most n
+// times per run, on average, as testing.AllocsPerRun measures it. It turns a
+// zero-allocation guarantee into a test failure:
+//
+//	Expect(func() { encoder.Encode(&buf, point) }).ToAllocateAtMost(0)
+//
+// It fails in a parallel test, where the allocations of the other tests would
+// be counted too.
+func (e *expectation) ToAllocateAtMost(n int) {
+	e.report(fmt.Sprintf("allocate at most %d times per run", n), func(fn any) error {
+		return assertions.ToAllocateAtMost(fn, n)
+	})
+}
+
+// ToRunFasterThan expects the value to be a func() whose median run, over the
+// given number of runs after a warm-up, takes less than d. A failure reports the
+// median, fastest and slowest runs.
+//
+//	Expect(func() { cache.Get("key") }).ToRunFasterThan(time.Microsecond, 1000)
+func (e *expectation) ToRunFasterThan(d time.Duration, iterations int) {
+	e.report(fmt.Sprintf("run faster than %s", d), func(fn any) error {
+		return assertions.ToRunFasterThan(fn, d, iterations)
+	})
+}
+
+// formatReturned formats the values given to ToReturn or ToReturnError.
+func formatReturned(values []any) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = internal.FormatValue(value)
+	}
+	return strings.Join(formatted, ", ")
 }
 
 func (e *expectation) ToBeCloseTo(target any, tolerance any) {
-	e.report(fmt.Sprintf("be within %v of %v", tolerance, target), assertions.ToBeCloseTo(e.checkExpectationAgainst, target, tolerance))
+	e.report(fmt.Sprintf("be within %v of %v", tolerance, target), func(value any) error {
+		return assertions.ToBeCloseTo(value, target, tolerance)
+	})
 }
 
 func (e *expectation) ToBeError() {
-	e.report("be an error", assertions.ToBeError(e.checkExpectationAgainst))
+	e.report("be an error", assertions.ToBeError)
+}
+
+func (e *expectation) ToMatchError(target error) {
+	e.report(fmt.Sprintf("match error %s", internal.FormatValue(target)), func(value any) error {
+		return assertions.ToMatchError(value, target)
+	})
+}
+
+// ToBeErrorAs expects the error, or an error it wraps, to be assignable to the
+// variable target points to, and assigns it, as errors.As does.
+//
+//	var pathErr *fs.PathError
+//	Expect(err).ToBeErrorAs(&pathErr)
+//	Expect(pathErr.Path).ToEqual("config.yml")
+func (e *expectation) ToBeErrorAs(target any) {
+	targetType := strings.TrimPrefix(fmt.Sprintf("%T", target), "*")
+	e.report(fmt.Sprintf("be an error assignable to %s", targetType), func(value any) error {
+		return assertions.ToBeErrorAs(value, target)
+	})
+}
+
+// ToHaveErrorMessage expects the error message to contain a substring, or to
+// match a *regexp.Regexp.
+func (e *expectation) ToHaveErrorMessage(substringOrRegex any) {
+	matcher := fmt.Sprintf("have an error message containing %#v", substringOrRegex)
+	if regex, ok := substringOrRegex.(*regexp.Regexp); ok {
+		matcher = fmt.Sprintf("have an error message matching %q", regex.String())
+	}
+	e.report(matcher, func(value any) error {
+		return assertions.ToHaveErrorMessage(value, substringOrRegex)
+	})
 }
 
 func (e *expectation) ToBeTrue() {
-	e.report("be true", assertions.ToBeTrue(e.checkExpectationAgainst))
+	e.report("be true", assertions.ToBeTrue)
 }
 
 func (e *expectation) ToBeFalse() {
-	e.report("be false", assertions.ToBeFalse(e.checkExpectationAgainst))
+	e.report("be false", assertions.ToBeFalse)
 }
 
 func (e *expectation) ToBeNil() {
-	e.report("be nil", assertions.ToBeNil(e.checkExpectationAgainst))
+	e.report("be nil", assertions.ToBeNil)
 }
 
 func (e *expectation) ToBeOfSameTypeAs(compareVal any) {
-	e.report(fmt.Sprintf("be of the same type as %#v", compareVal), assertions.ToBeOfSameTypeAs(e.checkExpectationAgainst, compareVal))
+	e.report(fmt.Sprintf("be of the same type as %#v", compareVal), func(value any) error {
+		return assertions.ToBeOfSameTypeAs(value, compareVal)
+	})
 }
 
 func (e *expectation) ToBeString() {
-	e.report("be a string", assertions.ToBeString(e.checkExpectationAgainst))
+	e.report("be a string", assertions.ToBeString)
 }
 
 func (e *expectation) ToBeGreaterThan(checkIfGreaterAgainst any) {
-	e.report(fmt.Sprintf("be greater than %v", checkIfGreaterAgainst), assertions.ToBeGreaterThan(e.checkExpectationAgainst, checkIfGreaterAgainst))
+	e.report(fmt.Sprintf("be greater than %v", checkIfGreaterAgainst), func(value any) error {
+		return assertions.ToBeGreaterThan(value, checkIfGreaterAgainst)
+	})
 }
 
 func (e *expectation) ToBeGreaterThanOrEqualTo(checkIfGreaterOrEqualAgainst any) {
-	e.report(fmt.Sprintf("be greater than or equal to %v", checkIfGreaterOrEqualAgainst), assertions.ToBeGreaterThanOrEqualTo(e.checkExpectationAgainst, checkIfGreaterOrEqualAgainst))
+	e.report(fmt.Sprintf("be greater than or equal to %v", checkIfGreaterOrEqualAgainst), func(value any) error {
+		return assertions.ToBeGreaterThanOrEqualTo(value, checkIfGreaterOrEqualAgainst)
+	})
 }
 
 func (e *expectation) ToBeLessThan(checkIfLessAgainst any) {
-	e.report(fmt.Sprintf("be less than %v", checkIfLessAgainst), assertions.ToBeLessThan(e.checkExpectationAgainst, checkIfLessAgainst))
+	e.report(fmt.Sprintf("be less than %v", checkIfLessAgainst), func(value any) error {
+		return assertions.ToBeLessThan(value, checkIfLessAgainst)
+	})
 }
 
 func (e *expectation) ToBeLessThanOrEqualTo(checkIfLessAgainst any) {
-	e.report(fmt.Sprintf("be less than or equal to %v", checkIfLessAgainst), assertions.ToBeLessThanOrEqualTo(e.checkExpectationAgainst, checkIfLessAgainst))
+	e.report(fmt.Sprintf("be less than or equal to %v", checkIfLessAgainst), func(value any) error {
+		return assertions.ToBeLessThanOrEqualTo(value, checkIfLessAgainst)
+	})
 }
 
 func (e *expectation) ToBePositive() {
-	e.report("be positive", assertions.ToBePositive(e.checkExpectationAgainst))
+	e.report("be positive", assertions.ToBePositive)
 }
 
 func (e *expectation) ToBeNegative() {
-	e.report("be negative", assertions.ToBeNegative(e.checkExpectationAgainst))
+	e.report("be negative", assertions.ToBeNegative)
+}
+
+// ToBeBefore expects the value to be a time strictly before t.
+//
+//	Expect(order.ShippedAt).ToBeBefore(order.DeliveredAt)
+func (e *expectation) ToBeBefore(t time.Time) {
+	e.report("be before "+internal.FormatTime(t), func(value any) error {
+		return assertions.ToBeBefore(value, t)
+	})
+}
+
+// ToBeAfter expects the value to be a time strictly after t.
+func (e *expectation) ToBeAfter(t time.Time) {
+	e.report("be after "+internal.FormatTime(t), func(value any) error {
+		return assertions.ToBeAfter(value, t)
+	})
+}
+
+// ToBeWithinDuration expects the value to be a time at most delta before or
+// after t.
+//
+//	Expect(user.CreatedAt).ToBeWithinDuration(time.Now(), time.Second)
+func (e *expectation) ToBeWithinDuration(t time.Time, delta time.Duration) {
+	e.report(fmt.Sprintf("be within %s of %s", delta, internal.FormatTime(t)), func(value any) error {
+		return assertions.ToBeWithinDuration(value, t, delta)
+	})
+}
+
+// ToBeSameInstantAs expects the value to be a time at the same instant as t,
+// whatever their locations and monotonic clock readings. ToEqual would tell
+// apart the same instant in UTC and in another location.
+func (e *expectation) ToBeSameInstantAs(t time.Time) {
+	e.report("be the same instant as "+internal.FormatTime(t), func(value any) error {
+		return assertions.ToBeSameInstantAs(value, t)
+	})
 }
 
 func (e *expectation) ToHaveLength(length int) {
-	e.report(fmt.Sprintf("have length %d", length), assertions.ToHaveLength(e.checkExpectationAgainst, length))
+	e.report(fmt.Sprintf("have length %d", length), func(value any) error {
+		return assertions.ToHaveLength(value, length)
+	})
 }
 
 func (e *expectation) ToHaveLengthGreaterThan(length int) {
-	e.report(fmt.Sprintf("have length greater than %d", length), assertions.ToHaveLengthGreaterThan(e.checkExpectationAgainst, length))
+	e.report(fmt.Sprintf("have length greater than %d", length), func(value any) error {
+		return assertions.ToHaveLengthGreaterThan(value, length)
+	})
 }
 
 func (e *expectation) ToHaveLengthLessThan(length int) {
-	e.report(fmt.Sprintf("have length less than %d", length), assertions.ToHaveLengthLessThan(e.checkExpectationAgainst, length))
+	e.report(fmt.Sprintf("have length less than %d", length), func(value any) error {
+		return assertions.ToHaveLengthLessThan(value, length)
+	})
 }
 
 // The Not* methods below are kept for backwards compatibility; each is just the
 // corresponding matcher routed through the uniform Not() path.
 
-func (e *expectation) NotToEqual(actual any) { e.Not().ToEqual(actual) }
+func (e *expectation) NotToEqual(expected any, options ...EqualOption) {
+	e.Not().ToEqual(expected, options...)
+}
 
 func (e *expectation) NotToBeError() { e.Not().ToBeError() }
 
 func (e *expectation) NotToBeNil() { e.Not().ToBeNil() }
+
+// ToReceive expects a value to be received from the channel within the given
+// duration. Each of the optional args either checks the received value — a
+// Matcher, or any other value it must equal — or captures it: a pointer to a
+// variable the value is assigned to.
+//
+//	var result Result
+//	Expect(results).ToReceive(time.Second, &result)
+//	Expect(errs).ToReceive(time.Second, io.EOF)
+func (e *expectation) ToReceive(within time.Duration, args ...any) {
+	e.report(fmt.Sprintf("receive a value within %v", within), func(value any) error {
+		return assertions.ToReceive(value, within, func(received any) error {
+			return matchReceived(received, args)
+		})
+	})
+}
+
+func (e *expectation) ToReceiveNothing(within time.Duration) {
+	e.report(fmt.Sprintf("receive nothing within %v", within), func(value any) error {
+		return assertions.ToReceiveNothing(value, within)
+	})
+}
+
+func (e *expectation) ToBeClosed() {
+	e.report("be closed", assertions.ToBeClosed)
+}
+
+func (e *expectation) ToBeDone() {
+	e.report("be done", assertions.ToBeDone)
+}
+
+func (e *expectation) ToBeCanceled() {
+	e.report("be canceled", assertions.ToBeCanceled)
+}
+
+func (e *expectation) ToHaveBeenCalled() {
+	e.report("have been called", func(value any) error {
+		calls, err := callArgsOf(value)
+		if err != nil {
+			return err
+		}
+		return assertions.ToHaveBeenCalled(calls)
+	})
+}
+
+func (e *expectation) ToHaveBeenCalledTimes(times int) {
+	e.report(fmt.Sprintf("have been called %d times", times), func(value any) error {
+		calls, err := callArgsOf(value)
+		if err != nil {
+			return err
+		}
+		return assertions.ToHaveBeenCalledTimes(calls, times)
+	})
+}
+
+func (e *expectation) ToHaveBeenCalledWith(args ...any) {
+	e.report(fmt.Sprintf("have been called with %#v", args), func(value any) error {
+		calls, err := callArgsOf(value)
+		if err != nil {
+			return err
+		}
+		return assertions.ToHaveBeenCalledWith(calls, args)
+	})
+}
+
+func (e *expectation) ToHaveBeenLastCalledWith(args ...any) {
+	e.report(fmt.Sprintf("have been last called with %#v", args), func(value any) error {
+		calls, err := callArgsOf(value)
+		if err != nil {
+			return err
+		}
+		return assertions.ToHaveBeenLastCalledWith(calls, args)
+	})
+}
@@ -0,0 +1,35 @@
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// receivableChannel returns the value of ch if it is a channel that can be
+// received from.
+func receivableChannel(ch any) (reflect.Value, error) {
+	if ch == nil || reflect.TypeOf(ch).Kind() != reflect.Chan {
+		return reflect.Value{}, fmt.Errorf("%#v is not a channel", ch)
+	}
+	chValue := reflect.ValueOf(ch)
+	if chValue.Type().ChanDir()&reflect.RecvDir == 0 {
+		return reflect.Value{}, fmt.Errorf("%s is send-only, so it cannot be received from", chValue.Type())
+	}
+	return chValue, nil
+}
+
+// receiveWithin receives from ch, waiting at most within. received is false when
+// nothing arrived in time or ch is closed, which closed tells apart.
+func receiveWithin(ch reflect.Value, within time.Duration) (value reflect.Value, received bool, closed bool) {
+	timer := time.NewTimer(within)
+	defer timer.Stop()
+	chosen, value, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: ch},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+	})
+	if chosen == 1 {
+		return reflect.Value{}, false, false
+	}
+	return value, ok, !ok
+}
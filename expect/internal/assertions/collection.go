@@ -0,0 +1,303 @@
+package assertions
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// maxFormattedElements bounds the elements of an iterator shown in a failure
+// message, since an iterator may never end.
+const maxFormattedElements = 100
+
+// collection is a value whose elements can be ranged over: a slice, an array, a
+// map, an iterator, or a value with an All method that returns an iterator. An
+// iterator is a function of the shape of iter.Seq or iter.Seq2, whatever its
+// type is named. Keyed collections — maps and iter.Seq2 iterators — yield keys
+// and values; the others yield positions and elements.
+type collection struct {
+	value reflect.Value
+	keyed bool
+}
+
+// asCollection returns v as a collection, if it is one.
+func asCollection(v any) (collection, bool) {
+	if v == nil {
+		return collection{}, false
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		return collection{value: value}, true
+	case reflect.Map:
+		return collection{value: value, keyed: true}, true
+	}
+
+	if keyed, ok := iteratorShape(value.Type()); ok {
+		return collection{value: value, keyed: keyed}, !value.IsNil()
+	}
+	if value.Kind() == reflect.Pointer && value.IsNil() {
+		// All would be called on a nil receiver.
+		return collection{}, false
+	}
+	all := value.MethodByName("All")
+	if !all.IsValid() || all.Type().NumIn() != 0 || all.Type().NumOut() != 1 {
+		return collection{}, false
+	}
+	if keyed, ok := iteratorShape(all.Type().Out(0)); ok {
+		iterator := all.Call(nil)[0]
+		return collection{value: iterator, keyed: keyed}, !iterator.IsNil()
+	}
+	return collection{}, false
+}
+
+// isNilCollection reports whether v is a nil pointer to a collection, one whose
+// type has an All or Len method. Those methods are not called on it, since most
+// would dereference the nil receiver.
+func isNilCollection(v any) bool {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || !value.IsNil() {
+		return false
+	}
+	_, hasLen := v.(interface{ Len() int })
+	return hasLen || value.MethodByName("All").IsValid()
+}
+
+// notACollection returns the error for a container that is not a collection,
+// telling a nil collection apart.
+func notACollection(container any) error {
+	if isNilCollection(container) {
+		return fmt.Errorf("%#v is a nil collection", container)
+	}
+	return fmt.Errorf("%#v is not a collection", container)
+}
+
+// iteratorShape reports whether typ has the shape of iter.Seq, a
+// func(yield func(V) bool), or of iter.Seq2, a func(yield func(K, V) bool), and
+// then whether it is keyed, as iter.Seq2 is.
+func iteratorShape(typ reflect.Type) (keyed, ok bool) {
+	if typ.Kind() != reflect.Func || typ.NumIn() != 1 || typ.NumOut() != 0 || typ.IsVariadic() {
+		return false, false
+	}
+	yield := typ.In(0)
+	if yield.Kind() != reflect.Func || yield.NumOut() != 1 || yield.Out(0).Kind() != reflect.Bool || yield.IsVariadic() {
+		return false, false
+	}
+	switch yield.NumIn() {
+	case 1:
+		return false, true
+	case 2:
+		return true, true
+	}
+	return false, false
+}
+
+// each calls yield with the keys and values of the collection, or the positions
+// and elements, until yield returns false. The entries of a map are sorted by
+// key, so they come in a stable order.
+func (c collection) each(yield func(key, value reflect.Value) bool) {
+	switch c.value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := range c.value.Len() {
+			if !yield(reflect.ValueOf(i), c.value.Index(i)) {
+				return
+			}
+		}
+	case reflect.Map:
+		keys := c.value.MapKeys()
+		internal.SortValues(keys)
+		for _, key := range keys {
+			if !yield(key, c.value.MapIndex(key)) {
+				return
+			}
+		}
+	case reflect.Func:
+		yieldType := c.value.Type().In(0)
+		position := 0
+		c.value.Call([]reflect.Value{reflect.MakeFunc(yieldType, func(args []reflect.Value) []reflect.Value {
+			var more bool
+			if c.keyed {
+				more = yield(args[0], args[1])
+			} else {
+				more = yield(reflect.ValueOf(position), args[0])
+				position++
+			}
+			return []reflect.Value{reflect.ValueOf(more).Convert(yieldType.Out(0))}
+		})})
+	}
+}
+
+// elements returns the keys and values of the collection, or the positions and
+// elements, in the order each yields them.
+func (c collection) elements() (keys, values []reflect.Value) {
+	c.each(func(key, value reflect.Value) bool {
+		keys = append(keys, key)
+		values = append(values, value)
+		return true
+	})
+	return keys, values
+}
+
+// asPredicate returns a function checking an element against predicate, which
+// is a func(T) bool or a func(T) error. The function returns nil when the element
+// satisfies the predicate, or an error telling why not.
+func asPredicate(predicate any) (func(element reflect.Value) error, error) {
+	typ := reflect.TypeOf(predicate)
+	if typ == nil || typ.Kind() != reflect.Func || typ.NumIn() != 1 || typ.NumOut() != 1 || typ.IsVariadic() ||
+		(typ.Out(0).Kind() != reflect.Bool && typ.Out(0) != errorType) {
+		return nil, fmt.Errorf("the predicate %T is not a func(T) bool or a func(T) error", predicate)
+	}
+	predicateValue := reflect.ValueOf(predicate)
+	elementType := typ.In(0)
+	return func(element reflect.Value) error {
+		element, ok := asArgument(element, elementType)
+		if !ok {
+			return fmt.Errorf("it is not a %s", elementType)
+		}
+		result := predicateValue.Call([]reflect.Value{element})[0]
+		if result.Kind() == reflect.Bool {
+			if !result.Bool() {
+				return errors.New("it does not satisfy the predicate")
+			}
+			return nil
+		}
+		if err, _ := result.Interface().(error); err != nil {
+			return err
+		}
+		return nil
+	}, nil
+}
+
+// asArgument returns an element of a collection as an argument of type typ to a
+// function given by the user, taking it out of its interface if it is held in
+// one. ok is false when the element is not a typ.
+func asArgument(element reflect.Value, typ reflect.Type) (argument reflect.Value, ok bool) {
+	if element.Kind() == reflect.Interface {
+		if element.IsNil() {
+			return reflect.Zero(typ), isNillable(typ)
+		}
+		element = element.Elem()
+	}
+	return element, element.Type().AssignableTo(typ)
+}
+
+func isNillable(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return true
+	}
+	return false
+}
+
+// matchElements pairs each of the expected elements with a distinct actual
+// element equal to it, as many of them as can be paired, and returns the indexes
+// of those left unpaired on both sides. Pairing the first equal element found
+// isn't enough when the expected elements are placeholders that match several
+// actual ones, so it looks for a maximum matching.
+func matchElements(expected []any, actual []reflect.Value) (missing, unexpected []int) {
+	equal := make([][]bool, len(expected))
+	for i := range expected {
+		equal[i] = make([]bool, len(actual))
+		for j := range actual {
+			equal[i][j] = internal.ObjectsAreEqual(expected[i], actual[j].Interface())
+		}
+	}
+
+	pairedWith := make([]int, len(actual))
+	for j := range pairedWith {
+		pairedWith[j] = -1
+	}
+	// pair pairs the expected element i, pairing again the ones already paired
+	// if that frees an actual element for it.
+	var pair func(i int, visited []bool) bool
+	pair = func(i int, visited []bool) bool {
+		for j := range actual {
+			if visited[j] || !equal[i][j] {
+				continue
+			}
+			visited[j] = true
+			if pairedWith[j] < 0 || pair(pairedWith[j], visited) {
+				pairedWith[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range expected {
+		if !pair(i, make([]bool, len(actual))) {
+			missing = append(missing, i)
+		}
+	}
+	for j, i := range pairedWith {
+		if i < 0 {
+			unexpected = append(unexpected, j)
+		}
+	}
+	return missing, unexpected
+}
+
+// elementAt formats the element of a collection at key, e.g. [2]: 5.
+func elementAt(key, value reflect.Value) string {
+	return fmt.Sprintf("[%#v]: %#v", key.Interface(), value.Interface())
+}
+
+// length returns the length of v: what len returns for the kinds it takes,
+// what a Len method returns, or else the number of elements of a collection.
+func length(v any) (int, error) {
+	if v == nil {
+		return 0, errors.New("a nil value cannot be checked if it has length, but it is")
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return reflect.ValueOf(v).Len(), nil
+	}
+	if isNilCollection(v) {
+		return 0, fmt.Errorf("%#v is a nil collection, so it does not have a length", v)
+	}
+	if lener, ok := v.(interface{ Len() int }); ok {
+		return lener.Len(), nil
+	}
+	if c, ok := asCollection(v); ok {
+		count := 0
+		c.each(func(_, _ reflect.Value) bool {
+			count++
+			return true
+		})
+		return count, nil
+	}
+	typeName := reflect.TypeOf(v).Name()
+	return 0, fmt.Errorf("type %v cannot be passed to the len() function, so it does not have a length", typeName)
+}
+
+// formatCollection formats a collection for a failure message as %#v does,
+// except for an iterator, which is shown with the elements it yields, e.g.
+// iter.Seq[int]{1, 2, 3}.
+func formatCollection(v any) string {
+	c, ok := asCollection(v)
+	if !ok || c.value.Kind() != reflect.Func {
+		return fmt.Sprintf("%#v", v)
+	}
+	var elements []string
+	truncated := false
+	c.each(func(key, value reflect.Value) bool {
+		if len(elements) == maxFormattedElements {
+			truncated = true
+			return false
+		}
+		if c.keyed {
+			elements = append(elements, fmt.Sprintf("%#v: %#v", key.Interface(), value.Interface()))
+		} else {
+			elements = append(elements, fmt.Sprintf("%#v", value.Interface()))
+		}
+		return true
+	})
+	if truncated {
+		elements = append(elements, "...")
+	}
+	return fmt.Sprintf("%s{%s}", reflect.TypeOf(v), strings.Join(elements, ", "))
+}
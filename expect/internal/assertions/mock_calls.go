@@ -0,0 +1,69 @@
+package assertions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// formatCallArgs formats the arguments of a call as an argument list.
+func formatCallArgs(args []any) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = fmt.Sprintf("%#v", arg)
+	}
+	return "(" + strings.Join(formatted, ", ") + ")"
+}
+
+// formatCalls lists the calls of a mock function, numbered from 1.
+func formatCalls(calls [][]any) string {
+	if len(calls) == 0 {
+		return "it was not called"
+	}
+	listed := fmt.Sprintf("it was called %s:", timesPhrase(len(calls)))
+	for i, args := range calls {
+		listed += fmt.Sprintf("\n  %d: %s", i+1, formatCallArgs(args))
+	}
+	return listed
+}
+
+// callArgsAreEqual reports whether a call was made with the expected arguments.
+func callArgsAreEqual(expected, args []any) bool {
+	if len(expected) != len(args) {
+		return false
+	}
+	for i := range expected {
+		if !internal.ObjectsAreEqual(expected[i], args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// closestCall returns the index of the call whose arguments are closest to the
+// expected ones: the most arguments equal at the same position, and the fewest
+// missing or extra ones. Ties go to the earliest call.
+func closestCall(calls [][]any, expected []any) int {
+	closest, closestScore := 0, 0
+	for i, args := range calls {
+		score := 0
+		for j := 0; j < len(args) && j < len(expected); j++ {
+			if internal.ObjectsAreEqual(expected[j], args[j]) {
+				score++
+			}
+		}
+		score -= max(len(args), len(expected)) - min(len(args), len(expected))
+		if i == 0 || score > closestScore {
+			closest, closestScore = i, score
+		}
+	}
+	return closest
+}
+
+func timesPhrase(n int) string {
+	if n == 1 {
+		return "1 time"
+	}
+	return fmt.Sprintf("%d times", n)
+}
@@ -0,0 +1,113 @@
+package assertions
+
+import (
+	"cmp"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// number is a value of any of Go's integer and float types, or a *big.Int,
+// *big.Float or *big.Rat, normalised so that numbers of different types compare
+// exactly: a finite number is held as a rational, which represents any of them
+// without rounding, and the infinities and NaN of floats are kept apart.
+type number struct {
+	rat *big.Rat
+	// inf is +1 or -1 for the infinities, and 0 for the other numbers.
+	inf int
+	nan bool
+}
+
+// asNumber normalises value, if it is a number. Named types of numeric kinds,
+// such as time.Duration, are numbers too.
+func asNumber(value any) (number, bool) {
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return number{}, false
+		}
+		return number{rat: new(big.Rat).SetInt(v)}, true
+	case *big.Rat:
+		if v == nil {
+			return number{}, false
+		}
+		return number{rat: new(big.Rat).Set(v)}, true
+	case *big.Float:
+		if v == nil {
+			return number{}, false
+		}
+		if v.IsInf() {
+			return number{inf: v.Sign()}, true
+		}
+		rat, _ := v.Rat(nil)
+		return number{rat: rat}, true
+	}
+	if value == nil {
+		return number{}, false
+	}
+
+	reflectVal := reflect.ValueOf(value)
+	switch reflectVal.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{rat: new(big.Rat).SetInt64(reflectVal.Int())}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{rat: new(big.Rat).SetInt(new(big.Int).SetUint64(reflectVal.Uint()))}, true
+	case reflect.Float32, reflect.Float64:
+		return floatNumber(reflectVal.Float()), true
+	}
+	return number{}, false
+}
+
+func floatNumber(f float64) number {
+	switch {
+	case math.IsNaN(f):
+		return number{nan: true}
+	case math.IsInf(f, 1):
+		return number{inf: 1}
+	case math.IsInf(f, -1):
+		return number{inf: -1}
+	}
+	return number{rat: new(big.Rat).SetFloat64(f)}
+}
+
+// compare orders two numbers. ok is false when either is NaN, which is not
+// ordered.
+func (n number) compare(other number) (result compareResult, ok bool) {
+	if n.nan || other.nan {
+		return compareEqual, false
+	}
+	if n.inf != 0 || other.inf != 0 {
+		return compareResult(cmp.Compare(n.inf, other.inf)), true
+	}
+	return compareResult(n.rat.Cmp(other.rat)), true
+}
+
+// distance returns |n - other|: NaN if either is NaN, 0 between equal
+// infinities, and +Inf between an infinity and any other number.
+func (n number) distance(other number) number {
+	switch {
+	case n.nan || other.nan:
+		return number{nan: true}
+	case n.inf != 0 && n.inf == other.inf:
+		return number{rat: new(big.Rat)}
+	case n.inf != 0 || other.inf != 0:
+		return number{inf: 1}
+	}
+	return number{rat: new(big.Rat).Abs(new(big.Rat).Sub(n.rat, other.rat))}
+}
+
+func (n number) String() string {
+	switch {
+	case n.nan:
+		return "NaN"
+	case n.inf > 0:
+		return "+Inf"
+	case n.inf < 0:
+		return "-Inf"
+	case n.rat.IsInt():
+		return n.rat.Num().String()
+	}
+	f, _ := n.rat.Float64()
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,43 @@
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// asTime returns the value as a time.Time, if it is one, a non-nil pointer to
+// one, or of a type convertible to it.
+func asTime(value any) (time.Time, error) {
+	switch t := value.(type) {
+	case time.Time:
+		return t, nil
+	case *time.Time:
+		if t != nil {
+			return *t, nil
+		}
+	}
+	if value != nil && reflect.TypeOf(value).ConvertibleTo(timeType) {
+		return reflect.ValueOf(value).Convert(timeType).Interface().(time.Time), nil
+	}
+	return time.Time{}, fmt.Errorf("%s is not a time.Time", internal.FormatValue(value))
+}
+
+// comparedValue returns how a compared value is shown in a failure message:
+// times without their monotonic clock reading, other values as they are.
+func comparedValue(value any) any {
+	if t, ok := value.(time.Time); ok {
+		return internal.FormatTime(t)
+	}
+	return value
+}
+
+// absDuration returns the absolute difference between two times.
+func absDuration(a, b time.Time) time.Duration {
+	if a.Before(b) {
+		return b.Sub(a)
+	}
+	return a.Sub(b)
+}
@@ -0,0 +1,44 @@
+package assertions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxListedElements bounds the elements listed in a failure message.
+const maxListedElements = 10
+
+// ToAllSatisfy asserts that every element of a collection satisfies predicate, a
+// func(T) bool or a func(T) error. An empty collection satisfies any predicate.
+//
+//	ToAllSatisfy([]int{2, 4}, func(n int) bool { return n%2 == 0 })
+func ToAllSatisfy(container, predicate any) error {
+	c, ok := asCollection(container)
+	if !ok {
+		return notACollection(container)
+	}
+	check, err := asPredicate(predicate)
+	if err != nil {
+		return err
+	}
+
+	keys, values := c.elements()
+	var failures []string
+	for i, value := range values {
+		if err := check(value); err != nil {
+			failures = append(failures, fmt.Sprintf("  %s: %v", elementAt(keys[i], value), err))
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of the %d elements do not satisfy the predicate:\n%s", len(failures), len(values), listElements(failures))
+}
+
+// listElements joins the lines describing elements, up to maxListedElements.
+func listElements(lines []string) string {
+	if len(lines) > maxListedElements {
+		lines = append(lines[:maxListedElements:maxListedElements], fmt.Sprintf("  ... and %d more", len(lines)-maxListedElements))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,51 @@
+package assertions
+
+import (
+	"fmt"
+	"testing"
+)
+
+// allocationRuns is the number of runs testing.AllocsPerRun averages the
+// allocations of a function over.
+const allocationRuns = 100
+
+// parallelTestPanic is what testing.AllocsPerRun panics with when another test
+// runs in parallel, since it cannot tell their allocations apart.
+const parallelTestPanic = "testing: AllocsPerRun called during parallel test"
+
+// ToAllocateAtMost asserts that the given argument is a func() that allocates at
+// most n times per run, on average over allocationRuns runs, as measured by
+// testing.AllocsPerRun. The function must be a func() rather than any
+// no-argument function, since calling one through reflection allocates. In a
+// parallel test, the allocations cannot be measured, and it returns an error.
+//
+//	ToAllocateAtMost(func() { buf.Reset(); buf.WriteString("x") }, 0)
+func ToAllocateAtMost(fn any, n int) error {
+	f, ok := fn.(func())
+	if !ok {
+		return fmt.Errorf("%#v is not a func()", fn)
+	}
+	allocations, err := allocsPerRun(f)
+	if err != nil {
+		return err
+	}
+	if allocations > float64(n) {
+		return fmt.Errorf("the function made %v allocations per run, more than %d (average of %d runs)", allocations, n, allocationRuns)
+	}
+	return nil
+}
+
+// allocsPerRun measures the allocations of f with testing.AllocsPerRun, turning
+// its panic in a parallel test into an error. Other panics, such as one from f,
+// are passed on.
+func allocsPerRun(f func()) (allocations float64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if r != parallelTestPanic {
+				panic(r)
+			}
+			err = fmt.Errorf("allocations cannot be measured in a parallel test, since the allocations of the tests running alongside it would be counted too; check them in a test that does not call t.Parallel")
+		}
+	}()
+	return testing.AllocsPerRun(allocationRuns, f), nil
+}
@@ -0,0 +1,23 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToBeAfter asserts that value is a time strictly after t.
+func ToBeAfter(value any, t time.Time) error {
+	v, err := asTime(value)
+	if err != nil {
+		return err
+	}
+	switch {
+	case v.Equal(t):
+		return fmt.Errorf("%s is not after %s: it is the same instant", internal.FormatTime(v), internal.FormatTime(t))
+	case v.Before(t):
+		return fmt.Errorf("%s is not after %s: it is %s before", internal.FormatTime(v), internal.FormatTime(t), t.Sub(v))
+	}
+	return nil
+}
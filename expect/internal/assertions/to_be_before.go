@@ -0,0 +1,23 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToBeBefore asserts that value is a time strictly before t.
+func ToBeBefore(value any, t time.Time) error {
+	v, err := asTime(value)
+	if err != nil {
+		return err
+	}
+	switch {
+	case v.Equal(t):
+		return fmt.Errorf("%s is not before %s: it is the same instant", internal.FormatTime(v), internal.FormatTime(t))
+	case v.After(t):
+		return fmt.Errorf("%s is not before %s: it is %s after", internal.FormatTime(v), internal.FormatTime(t), v.Sub(t))
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package assertions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToBeCanceled asserts that the given context was canceled. A context that is
+// done because its deadline passed is not canceled.
+//
+//	ToBeCanceled(ctx)
+func ToBeCanceled(ctx any) error {
+	c, ok := ctx.(context.Context)
+	if !ok {
+		return fmt.Errorf("%#v is not a context.Context", ctx)
+	}
+	if !errors.Is(c.Err(), context.Canceled) {
+		return fmt.Errorf("the context is not canceled (%s)", internal.DescribeContext(c))
+	}
+	return nil
+}
@@ -2,47 +2,66 @@ package assertions
 
 import (
 	"fmt"
-	"math"
-	"reflect"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
 )
 
 // ToBeCloseTo asserts that a numeric value is within tolerance (inclusive) of
-// target. value, target and tolerance are all converted to float64, so mixed
-// numeric types are accepted.
+// target. value, target and tolerance may be of any numeric types, mixed,
+// including *big.Int, *big.Float and *big.Rat, and are compared exactly. An
+// infinity is only close to itself, unless the tolerance is infinite. A
+// time.Duration is a number of nanoseconds like any other, and the difference
+// from a duration is shown as a duration. A time.Time value is compared to a
+// time target within a duration tolerance, as ToBeWithinDuration does.
 //
 //	ToBeCloseTo(3.14159, 3.14, 0.01)
+//	ToBeCloseTo(elapsed, time.Second, 100*time.Millisecond)
 func ToBeCloseTo(val, target, tolerance any) error {
-	v, ok := numericAsFloat64(val)
+	if v, ok := val.(time.Time); ok {
+		return toBeCloseToTime(v, target, tolerance)
+	}
+
+	v, ok := asNumber(val)
 	if !ok {
 		return fmt.Errorf("%#v is not a number", val)
 	}
-	tg, ok := numericAsFloat64(target)
+	tg, ok := asNumber(target)
 	if !ok {
 		return fmt.Errorf("%#v is not a number", target)
 	}
-	tol, ok := numericAsFloat64(tolerance)
+	tol, ok := asNumber(tolerance)
 	if !ok {
 		return fmt.Errorf("%#v is not a number", tolerance)
 	}
 
-	if diff := math.Abs(v - tg); diff > tol {
-		return fmt.Errorf("%v is not within %v of %v (differs by %v)", v, tol, tg, diff)
+	diff := v.distance(tg)
+	if result, ok := diff.compare(tol); !ok || result == compareGreater {
+		return fmt.Errorf("%v is not within %v of %v (differs by %s)", val, tolerance, target, formatDistance(val, diff))
 	}
 	return nil
 }
 
-func numericAsFloat64(val any) (float64, bool) {
-	if val == nil {
-		return 0, false
-	}
-	reflectVal := reflect.ValueOf(val)
-	switch reflectVal.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return float64(reflectVal.Int()), true
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return float64(reflectVal.Uint()), true
-	case reflect.Float32, reflect.Float64:
-		return reflectVal.Float(), true
-	}
-	return 0, false
+func toBeCloseToTime(val time.Time, target, tolerance any) error {
+	tg, ok := target.(time.Time)
+	if !ok {
+		return fmt.Errorf("%s is not a time.Time", internal.FormatValue(target))
+	}
+	tol, ok := tolerance.(time.Duration)
+	if !ok {
+		return fmt.Errorf("%s is not a time.Duration", internal.FormatValue(tolerance))
+	}
+	return ToBeWithinDuration(val, tg, tol)
+}
+
+// formatDistance formats the distance of val from its target. When val is a
+// duration, so is the distance, or a number of nanoseconds if it doesn't fit.
+func formatDistance(val any, distance number) string {
+	if _, ok := val.(time.Duration); !ok || distance.rat == nil {
+		return distance.String()
+	}
+	if distance.rat.IsInt() && distance.rat.Num().IsInt64() {
+		return time.Duration(distance.rat.Num().Int64()).String()
+	}
+	return distance.String() + "ns"
 }
@@ -0,0 +1,37 @@
+package assertions
+
+import (
+	"fmt"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToBeClosed asserts that the given channel is closed. A closed channel that
+// still holds buffered values can't be told apart from an open one without
+// receiving them, so the check fails without receiving when values are
+// buffered. Otherwise it tries to receive: a closed channel yields its zero
+// value at once. On a channel with nothing buffered, a sender blocked on it
+// hands its value over to that receive, and the value is lost to the code
+// under test.
+//
+//	ToBeClosed(done)
+func ToBeClosed(ch any) error {
+	chValue, err := receivableChannel(ch)
+	if err != nil {
+		return err
+	}
+	if chValue.IsNil() {
+		return fmt.Errorf("%s is not closed", internal.DescribeChannel(chValue))
+	}
+	if chValue.Len() > 0 {
+		return fmt.Errorf("%s still holds buffered values, so it is open, or closed but not drained", internal.DescribeChannel(chValue))
+	}
+	value, ok := chValue.TryRecv()
+	if ok {
+		return fmt.Errorf("received %#v from %s, so it is not closed", value.Interface(), internal.DescribeChannel(chValue))
+	}
+	if value.IsValid() {
+		return nil
+	}
+	return fmt.Errorf("%s is not closed", internal.DescribeChannel(chValue))
+}
@@ -0,0 +1,21 @@
+package assertions
+
+import (
+	"context"
+	"fmt"
+)
+
+// ToBeDone asserts that the given context is done: canceled, or past its
+// deadline.
+//
+//	ToBeDone(ctx)
+func ToBeDone(ctx any) error {
+	c, ok := ctx.(context.Context)
+	if !ok {
+		return fmt.Errorf("%#v is not a context.Context", ctx)
+	}
+	if c.Err() == nil {
+		return fmt.Errorf("the context is not done")
+	}
+	return nil
+}
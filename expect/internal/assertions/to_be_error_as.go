@@ -0,0 +1,39 @@
+package assertions
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+var errorInterface = reflect.TypeOf((*error)(nil)).Elem()
+
+// ToBeErrorAs asserts that the given error, or an error it wraps, can be assigned
+// to the variable target points to, as reported by errors.As. On success the
+// matching error is assigned to it.
+//
+//	var pathErr *fs.PathError
+//	ToBeErrorAs(err, &pathErr)
+func ToBeErrorAs(err, target any) error {
+	actualErr, ok := err.(error)
+	if !ok {
+		return fmt.Errorf("%#v is not an error", err)
+	}
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Pointer || targetValue.IsNil() {
+		return fmt.Errorf("the target must be a non-nil pointer to a variable, but it is %#v", target)
+	}
+	targetType := targetValue.Type().Elem()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(errorInterface) {
+		return fmt.Errorf("the target must point to an interface or to a type implementing error, but it points to %s", targetType)
+	}
+	if !errors.As(actualErr, target) {
+		return fmt.Errorf(
+			"expected an error in the chain to be assignable to %s (errors.As), but none is\n%s",
+			targetType, internal.FormatErrorChain(actualErr),
+		)
+	}
+	return nil
+}
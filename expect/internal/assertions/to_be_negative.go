@@ -1,8 +1,5 @@
 package assertions
 
-import "reflect"
-
 func ToBeNegative(isNegativeCandidate any) error {
-	zero := reflect.Zero(reflect.TypeOf(isNegativeCandidate))
-	return compareTwoValues(isNegativeCandidate, zero.Interface(), []compareResult{compareLess}, "\"%v\" is not negative")
+	return compareTwoValues(isNegativeCandidate, zeroOf(isNegativeCandidate), []compareResult{compareLess}, "\"%[1]v\" is not negative")
 }
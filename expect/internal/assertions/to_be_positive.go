@@ -3,6 +3,17 @@ package assertions
 import "reflect"
 
 func ToBePositive(isPositiveCandidate any) error {
-	zero := reflect.Zero(reflect.TypeOf(isPositiveCandidate))
-	return compareTwoValues(isPositiveCandidate, zero.Interface(), []compareResult{compareGreater}, "\"%v\" is not positive")
+	return compareTwoValues(isPositiveCandidate, zeroOf(isPositiveCandidate), []compareResult{compareGreater}, "\"%[1]v\" is not positive")
+}
+
+// zeroOf returns the value the sign of v is found by comparing it to: 0 for a
+// number, and the zero value of its type otherwise.
+func zeroOf(v any) any {
+	if _, ok := asNumber(v); ok {
+		return 0
+	}
+	if v == nil {
+		return nil
+	}
+	return reflect.Zero(reflect.TypeOf(v)).Interface()
 }
@@ -0,0 +1,22 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToBeSameInstantAs asserts that value is a time at the same instant as t,
+// whatever their locations and monotonic clock readings, as time.Time.Equal
+// does.
+func ToBeSameInstantAs(value any, t time.Time) error {
+	v, err := asTime(value)
+	if err != nil {
+		return err
+	}
+	if !v.Equal(t) {
+		return fmt.Errorf("%s is not the same instant as %s (differs by %s)", internal.FormatTime(v), internal.FormatTime(t), absDuration(v, t))
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ToBeSortedBy asserts that the elements of an ordered collection — a slice, an
+// array or an iterator — are sorted by less, a func(a, b T) bool reporting
+// whether a goes before b: no element goes before the one preceding it.
+//
+//	ToBeSortedBy([]string{"a", "b", "b"}, func(a, b string) bool { return a < b })
+func ToBeSortedBy(container, less any) error {
+	c, ok := asCollection(container)
+	if isNilCollection(container) {
+		return notACollection(container)
+	}
+	if !ok || c.value.Kind() == reflect.Map {
+		return fmt.Errorf("%#v is not a slice, array or iterator", container)
+	}
+	lessType := reflect.TypeOf(less)
+	if lessType == nil || lessType.Kind() != reflect.Func || lessType.NumIn() != 2 || lessType.In(0) != lessType.In(1) ||
+		lessType.NumOut() != 1 || lessType.Out(0).Kind() != reflect.Bool {
+		return fmt.Errorf("the less function %T is not a func(a, b T) bool", less)
+	}
+
+	keys, values := c.elements()
+	for i := 1; i < len(values); i++ {
+		previous, previousOk := asArgument(values[i-1], lessType.In(0))
+		current, currentOk := asArgument(values[i], lessType.In(0))
+		if !previousOk || !currentOk {
+			return fmt.Errorf("the elements of %s are not all %s", formatCollection(container), lessType.In(0))
+		}
+		if reflect.ValueOf(less).Call([]reflect.Value{current, previous})[0].Bool() {
+			return fmt.Errorf("%s is not sorted: %s goes before %s",
+				formatCollection(container), elementAt(keys[i], values[i]), elementAt(keys[i-1], values[i-1]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToBeWithinDuration asserts that value is a time at most delta (inclusive)
+// before or after t.
+//
+//	ToBeWithinDuration(order.CreatedAt, time.Now(), time.Second)
+func ToBeWithinDuration(value any, t time.Time, delta time.Duration) error {
+	v, err := asTime(value)
+	if err != nil {
+		return err
+	}
+	if diff := absDuration(v, t); diff > delta {
+		return fmt.Errorf("%s is not within %s of %s (differs by %s)", internal.FormatTime(v), delta, internal.FormatTime(t), diff)
+	}
+	return nil
+}
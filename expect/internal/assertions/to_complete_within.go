@@ -0,0 +1,78 @@
+package assertions
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToCompleteWithin asserts that the given argument is a function that returns
+// within timeout. It is a func() or a func(context.Context), which is passed a
+// context that is done once timeout has elapsed. A function that overruns is
+// left running, but is given as long again to return, so the failure can tell
+// how long it took.
+//
+//	ToCompleteWithin(func(ctx context.Context) { worker.Drain(ctx) }, time.Second)
+func ToCompleteWithin(fn any, timeout time.Duration) error {
+	if fn == nil || reflect.TypeOf(fn).Kind() != reflect.Func {
+		return fmt.Errorf("%#v is not a function", fn)
+	}
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+	takesContext := fnType.NumIn() == 1 && fnType.In(0) == contextType
+	if fnType.NumIn() != 0 && !takesContext {
+		return fmt.Errorf("the function passed to ToCompleteWithin must take no arguments or a context.Context")
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	// The function is timed where it runs, so the time it took doesn't depend
+	// on how soon its outcome is received.
+	type timedCall struct {
+		functionCall
+		elapsed time.Duration
+	}
+	call := fnValue
+	if takesContext {
+		call = reflect.ValueOf(func() { fnValue.Call([]reflect.Value{reflect.ValueOf(ctx)}) })
+	}
+	done := make(chan timedCall, 1)
+	go func() {
+		done <- timedCall{callFunction(call), time.Since(start)}
+	}()
+
+	var outcome timedCall
+	select {
+	case outcome = <-done:
+	case <-time.After(2 * timeout):
+		return fmt.Errorf("the function did not complete within %s: it was still running after %s",
+			timeout, roundDuration(time.Since(start)))
+	}
+
+	if outcome.panicked {
+		return fmt.Errorf("the function panicked with %s after %s", internal.FormatValue(outcome.recovered), roundDuration(outcome.elapsed))
+	}
+	if outcome.elapsed > timeout {
+		return fmt.Errorf("the function did not complete within %s: it took %s", timeout, roundDuration(outcome.elapsed))
+	}
+	return nil
+}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// roundDuration rounds a measured duration to a precision that is read at a
+// glance: to the millisecond from a second on, to the microsecond from a
+// millisecond on, and not at all below.
+func roundDuration(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond)
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond)
+	}
+	return d
+}
@@ -8,19 +8,25 @@ import (
 	"github.com/redjolr/goherent/expect/internal"
 )
 
-// ToContain asserts that the specified string, list(array, slice...) or map contains the
-// specified substring or element.
+// ToContain asserts that the specified string, list(array, slice...), map or
+// other collection contains the specified substring or element. Maps are
+// searched by key; keyed iterators (iter.Seq2), like the other collections, by
+// value.
 //
 //	a.Contains("Hello World", "World")
 //	a.Contains(["Hello", "World"], "World")
 //	a.Contains({"Hello": "World"}, "Hello")
+//	a.Contains(slices.Values(["Hello", "World"]), "World")
 func ToContain(container, containee any) error {
 	ok, found := containsElement(container, containee)
+	if !ok && isNilCollection(container) {
+		return notACollection(container)
+	}
 	if !ok {
-		return fmt.Errorf("%#v is not a string, slice, array or map", container)
+		return fmt.Errorf("%#v is not a string or a collection", container)
 	}
 	if !found {
-		return fmt.Errorf("%#v does not contain %#v", container, containee)
+		return fmt.Errorf("%s does not contain %#v", formatCollection(container), containee)
 	}
 	return nil
 }
@@ -49,20 +55,17 @@ func containsElement(list, element any) (ok, found bool) {
 		return true, strings.Contains(listValue.String(), elementValue.String())
 	}
 
-	if listKind == reflect.Map {
-		mapKeys := listValue.MapKeys()
-		for i := 0; i < len(mapKeys); i++ {
-			if internal.ObjectsAreEqual(mapKeys[i].Interface(), element) {
-				return true, true
-			}
-		}
-		return true, false
+	c, isCollection := asCollection(list)
+	if !isCollection {
+		return false, false
 	}
-
-	for i := 0; i < listValue.Len(); i++ {
-		if internal.ObjectsAreEqual(listValue.Index(i).Interface(), element) {
-			return true, true
+	c.each(func(key, value reflect.Value) bool {
+		candidate := value
+		if c.value.Kind() == reflect.Map {
+			candidate = key
 		}
-	}
-	return true, false
+		found = internal.ObjectsAreEqual(candidate.Interface(), element)
+		return !found
+	})
+	return true, found
 }
@@ -0,0 +1,33 @@
+package assertions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToContainAll asserts that a collection contains all the given elements, in
+// any order and among others. An element given n times must be found n times.
+// The elements of a map are its values.
+//
+//	ToContainAll([]int{3, 1, 2}, 1, 3)
+func ToContainAll(container any, elements ...any) error {
+	c, ok := asCollection(container)
+	if !ok {
+		return notACollection(container)
+	}
+	_, values := c.elements()
+	missing, _ := matchElements(elements, values)
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s does not contain all the elements:\nmissing: %s", formatCollection(container), formatElements(elements, missing))
+}
+
+// formatElements formats the elements at the given indexes.
+func formatElements(elements []any, indexes []int) string {
+	formatted := make([]string, len(indexes))
+	for i, index := range indexes {
+		formatted[i] = fmt.Sprintf("%#v", elements[index])
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -7,37 +7,27 @@ import (
 	"github.com/redjolr/goherent/expect/internal"
 )
 
-// ToContainElement asserts that the given slice, array or map contains the
-// specified element (compared by value). Unlike ToContain, it does not do
-// substring matching on strings — the container must be a collection. For maps,
-// the element is matched against the map's values (use ToHaveKey to match keys).
+// ToContainElement asserts that the given slice, array, map or other collection
+// contains the specified element (compared by value). Unlike ToContain, it does
+// not do substring matching on strings — the container must be a collection. For
+// maps and keyed iterators (iter.Seq2), the element is matched against the values
+// (use ToHaveKey to match the keys of a map).
 //
 //	ToContainElement([]string{"Hello", "World"}, "World")
 //	ToContainElement(map[string]int{"a": 1}, 1)
 func ToContainElement(container, element any) error {
-	if container == nil {
-		return fmt.Errorf("%#v is not a slice, array or map", container)
-	}
-	kind := reflect.TypeOf(container).Kind()
-	if kind != reflect.Slice && kind != reflect.Array && kind != reflect.Map {
-		return fmt.Errorf("%#v is not a slice, array or map", container)
-	}
-
-	containerValue := reflect.ValueOf(container)
-	if kind == reflect.Map {
-		iter := containerValue.MapRange()
-		for iter.Next() {
-			if internal.ObjectsAreEqual(iter.Value().Interface(), element) {
-				return nil
-			}
-		}
-		return fmt.Errorf("%#v does not contain element %#v", container, element)
+	c, ok := asCollection(container)
+	if !ok {
+		return notACollection(container)
 	}
 
-	for i := 0; i < containerValue.Len(); i++ {
-		if internal.ObjectsAreEqual(containerValue.Index(i).Interface(), element) {
-			return nil
-		}
+	found := false
+	c.each(func(_, value reflect.Value) bool {
+		found = internal.ObjectsAreEqual(value.Interface(), element)
+		return !found
+	})
+	if !found {
+		return fmt.Errorf("%s does not contain element %#v", formatCollection(container), element)
 	}
-	return fmt.Errorf("%#v does not contain element %#v", container, element)
+	return nil
 }
@@ -0,0 +1,36 @@
+package assertions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToContainExactlyInAnyOrder asserts that a collection contains the given
+// elements and nothing else, in any order: as many times each as they are given.
+// The elements of a map are its values.
+//
+//	ToContainExactlyInAnyOrder([]int{3, 1, 2}, 1, 2, 3)
+func ToContainExactlyInAnyOrder(container any, elements ...any) error {
+	c, ok := asCollection(container)
+	if !ok {
+		return notACollection(container)
+	}
+	keys, values := c.elements()
+	missing, unexpected := matchElements(elements, values)
+	if len(missing) == 0 && len(unexpected) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("%s does not contain exactly the elements, in any order:", formatCollection(container))
+	if len(missing) > 0 {
+		message += "\nmissing   : " + formatElements(elements, missing)
+	}
+	if len(unexpected) > 0 {
+		formatted := make([]string, len(unexpected))
+		for i, index := range unexpected {
+			formatted[i] = elementAt(keys[index], values[index])
+		}
+		message += "\nunexpected: " + strings.Join(formatted, ", ")
+	}
+	return fmt.Errorf("%s", message)
+}
@@ -7,6 +7,11 @@ import (
 )
 
 func ToEqual(expected, actual any) error {
+	return ToEqualWith(expected, actual, internal.EqualOptions{})
+}
+
+// ToEqualWith is ToEqual, with the comparison adjusted by options.
+func ToEqualWith(expected, actual any, options internal.EqualOptions) error {
 	if expected == nil && actual == nil {
 		return nil
 	}
@@ -14,8 +19,8 @@ func ToEqual(expected, actual any) error {
 		return fmt.Errorf("invalid operation: %#v == %#v (cannot take func type as argument)", expected, actual)
 	}
 
-	if !internal.ObjectsAreEqual(expected, actual) {
-		diff := internal.Diff(expected, actual)
+	if !internal.ObjectsAreEqualWith(expected, actual, options) {
+		diff := internal.DiffWith(expected, actual, options)
 		expected, actual = internal.FormatUnequalValues(expected, actual)
 		return fmt.Errorf("not equal:\n"+
 			"expected: %s\n"+
@@ -0,0 +1,50 @@
+package assertions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToEqualJSON asserts that value and expected are equivalent JSON documents,
+// whatever their key order and whitespace. Each of them is a JSON string or
+// []byte, or a value that is marshalled to JSON first.
+//
+//	ToEqualJSON(`{"b": [1, 2], "a": 1}`, `{"a":1,"b":[1,2]}`)
+func ToEqualJSON(value, expected any) error {
+	return toEqualDocument(internal.JSON, value, expected)
+}
+
+// ToEqualYAML is ToEqualJSON for YAML documents.
+func ToEqualYAML(value, expected any) error {
+	return toEqualDocument(internal.YAML, value, expected)
+}
+
+// ToEqualXML is ToEqualJSON for XML documents. The order of attributes doesn't
+// matter, nor do comments and the whitespace around elements and text.
+func ToEqualXML(value, expected any) error {
+	return toEqualDocument(internal.XML, value, expected)
+}
+
+func toEqualDocument(format internal.DocumentFormat, value, expected any) error {
+	actualTree, err := format.ParseDocument(value)
+	if err != nil {
+		return err
+	}
+	expectedTree, err := format.ParseDocument(expected)
+	if err != nil {
+		return fmt.Errorf("the expected document is invalid: %w", err)
+	}
+	if internal.ObjectsAreEqual(expectedTree, actualTree) {
+		return nil
+	}
+
+	if diff := internal.Diff(expectedTree, actualTree); diff != "" {
+		return fmt.Errorf("%s documents are not equal:%s", format.Name, strings.TrimPrefix(diff, "\n"))
+	}
+	return fmt.Errorf("%s documents are not equal:\n"+
+		"expected: %#v\n"+
+		"actual  : %#v", format.Name, expectedTree, actualTree,
+	)
+}
@@ -0,0 +1,14 @@
+package assertions
+
+import "fmt"
+
+// ToHaveBeenCalled asserts that a mock function, given by the arguments of its
+// calls, was called at least once.
+//
+//	ToHaveBeenCalled([][]any{{"ada"}})
+func ToHaveBeenCalled(calls [][]any) error {
+	if len(calls) == 0 {
+		return fmt.Errorf("expected the mock function to have been called, but it was not")
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package assertions
+
+import "fmt"
+
+// ToHaveBeenCalledTimes asserts that a mock function, given by the arguments of
+// its calls, was called exactly the given number of times.
+//
+//	ToHaveBeenCalledTimes([][]any{{"ada"}, {"grace"}}, 2)
+func ToHaveBeenCalledTimes(calls [][]any, times int) error {
+	if len(calls) != times {
+		return fmt.Errorf("expected the mock function to have been called %s, but %s", timesPhrase(times), formatCalls(calls))
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package assertions
+
+import (
+	"fmt"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToHaveBeenCalledWith asserts that a mock function, given by the arguments of
+// its calls, was called at least once with the expected arguments.
+//
+//	ToHaveBeenCalledWith([][]any{{"ada", 36}}, []any{"ada", 36})
+func ToHaveBeenCalledWith(calls [][]any, expected []any) error {
+	for _, args := range calls {
+		if callArgsAreEqual(expected, args) {
+			return nil
+		}
+	}
+	message := fmt.Sprintf("expected the mock function to have been called with %s, but %s", formatCallArgs(expected), formatCalls(calls))
+	if len(calls) > 0 {
+		closest := closestCall(calls, expected)
+		message += fmt.Sprintf("\nclosest call: %d%s", closest+1, internal.Diff(expected, calls[closest]))
+	}
+	return fmt.Errorf("%s", message)
+}
@@ -0,0 +1,22 @@
+package assertions
+
+import (
+	"fmt"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToHaveBeenLastCalledWith asserts that the last call of a mock function, given
+// by the arguments of its calls, was made with the expected arguments.
+//
+//	ToHaveBeenLastCalledWith([][]any{{"ada"}, {"grace"}}, []any{"grace"})
+func ToHaveBeenLastCalledWith(calls [][]any, expected []any) error {
+	if len(calls) > 0 && callArgsAreEqual(expected, calls[len(calls)-1]) {
+		return nil
+	}
+	message := fmt.Sprintf("expected the mock function to have been last called with %s, but %s", formatCallArgs(expected), formatCalls(calls))
+	if len(calls) > 0 {
+		message += fmt.Sprintf("\nlast call: %d%s", len(calls), internal.Diff(expected, calls[len(calls)-1]))
+	}
+	return fmt.Errorf("%s", message)
+}
@@ -0,0 +1,41 @@
+package assertions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToHaveErrorMessage asserts that the message of the given error contains the
+// given substring, or matches the given *regexp.Regexp.
+//
+//	ToHaveErrorMessage(err, "permission denied")
+//	ToHaveErrorMessage(err, regexp.MustCompile(`^open .*: permission denied$`))
+func ToHaveErrorMessage(err, substringOrRegex any) error {
+	actualErr, ok := err.(error)
+	if !ok {
+		return fmt.Errorf("%#v is not an error", err)
+	}
+	message := actualErr.Error()
+	switch expected := substringOrRegex.(type) {
+	case string:
+		if !strings.Contains(message, expected) {
+			return fmt.Errorf(
+				"expected the error message to contain %q, but it is %q\n%s",
+				expected, message, internal.FormatErrorChain(actualErr),
+			)
+		}
+	case *regexp.Regexp:
+		if !expected.MatchString(message) {
+			return fmt.Errorf(
+				"expected the error message to match %q, but it is %q\n%s",
+				expected.String(), message, internal.FormatErrorChain(actualErr),
+			)
+		}
+	default:
+		return fmt.Errorf("expected a substring or a *regexp.Regexp to check the error message against, but got %#v", substringOrRegex)
+	}
+	return nil
+}
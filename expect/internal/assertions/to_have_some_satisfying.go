@@ -0,0 +1,42 @@
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ToHaveSomeSatisfying asserts that at least one element of a collection
+// satisfies predicate, a func(T) bool or a func(T) error.
+//
+//	ToHaveSomeSatisfying([]int{1, 2}, func(n int) bool { return n%2 == 0 })
+func ToHaveSomeSatisfying(container, predicate any) error {
+	c, ok := asCollection(container)
+	if !ok {
+		return notACollection(container)
+	}
+	check, err := asPredicate(predicate)
+	if err != nil {
+		return err
+	}
+
+	// The elements are checked as they come, so an endless iterator can be
+	// checked too, as long as one of its elements satisfies the predicate.
+	var failures []string
+	satisfied := false
+	c.each(func(key, value reflect.Value) bool {
+		err := check(value)
+		if err == nil {
+			satisfied = true
+			return false
+		}
+		failures = append(failures, fmt.Sprintf("  %s: %v", elementAt(key, value), err))
+		return true
+	})
+	switch {
+	case satisfied:
+		return nil
+	case len(failures) == 0:
+		return fmt.Errorf("%s has no elements, so none satisfies the predicate", formatCollection(container))
+	}
+	return fmt.Errorf("none of the %d elements satisfies the predicate:\n%s", len(failures), listElements(failures))
+}
@@ -0,0 +1,36 @@
+package assertions
+
+import (
+	"fmt"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToHaveUniqueElements asserts that no two elements of a collection are equal.
+// The elements of a map are its values.
+//
+//	ToHaveUniqueElements([]int{1, 2, 3})
+func ToHaveUniqueElements(container any) error {
+	c, ok := asCollection(container)
+	if !ok {
+		return notACollection(container)
+	}
+	keys, values := c.elements()
+	var duplicates []string
+	duplicated := make([]bool, len(values))
+	for i := range values {
+		if duplicated[i] {
+			continue
+		}
+		for j := i + 1; j < len(values); j++ {
+			if !duplicated[j] && internal.ObjectsAreEqual(values[i].Interface(), values[j].Interface()) {
+				duplicated[j] = true
+				duplicates = append(duplicates, fmt.Sprintf("  %s is a duplicate of %s", elementAt(keys[j], values[j]), elementAt(keys[i], values[i])))
+			}
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s does not have unique elements:\n%s", formatCollection(container), listElements(duplicates))
+}
@@ -3,9 +3,14 @@ package assertions
 import (
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/redjolr/goherent/expect/internal"
 )
 
 // ToMatch asserts that the given string matches the regular expression pattern.
+// When the string spans several lines or has characters that can't be seen, the
+// failure shows it line by line, with those characters marked.
 //
 //	ToMatch("goherent v1.2.3", `^goherent v\d+\.\d+\.\d+$`)
 func ToMatch(val any, pattern string) error {
@@ -18,7 +23,11 @@ func ToMatch(val any, pattern string) error {
 		return fmt.Errorf("invalid regex pattern %q: %s", pattern, err)
 	}
 	if !matched {
-		return fmt.Errorf("%q does not match pattern %q", str, pattern)
+		message := fmt.Sprintf("%q does not match pattern %q", str, pattern)
+		if strings.Contains(str, "\n") || internal.NeedsVisibleString(str) {
+			message += "\nstring:\n  " + strings.ReplaceAll(internal.VisibleString(str), "\n", "\n  ")
+		}
+		return fmt.Errorf("%s", message)
 	}
 	return nil
 }
@@ -0,0 +1,30 @@
+package assertions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToMatchError asserts that the given error is, or wraps, the target error, as
+// reported by errors.Is.
+//
+//	ToMatchError(fmt.Errorf("load: %w", fs.ErrNotExist), fs.ErrNotExist)
+func ToMatchError(err, target any) error {
+	actualErr, ok := err.(error)
+	if !ok {
+		return fmt.Errorf("%#v is not an error", err)
+	}
+	targetErr, ok := target.(error)
+	if !ok {
+		return fmt.Errorf("the target %#v is not an error", target)
+	}
+	if !errors.Is(actualErr, targetErr) {
+		return fmt.Errorf(
+			"expected the error to match %s (errors.Is), but nothing in its chain does\n%s",
+			internal.FormatValue(targetErr), internal.FormatErrorChain(actualErr),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToMatchObject asserts that the given struct or map contains at least the
+// fields or keys of subset, with equal values. Nested structs and maps in subset
+// are matched the same way, recursively, and so are the elements of its slices,
+// which must have as many elements as the slices they match. Subset is a map, or
+// a struct whose zero-valued fields are ignored.
+//
+//	ToMatchObject(user, map[string]any{"Name": "ada", "Address": map[string]any{"City": "London"}})
+func ToMatchObject(object, subset any) error {
+	subsetValue := indirect(reflect.ValueOf(subset))
+	if !isObject(subsetValue) {
+		return fmt.Errorf("the subset to match must be a struct or a map, but it is %#v", subset)
+	}
+	objectValue := indirect(reflect.ValueOf(object))
+	if !isObject(objectValue) {
+		return fmt.Errorf("%#v is not a struct or a map", object)
+	}
+
+	mismatches := matchValue(subsetValue, objectValue, "")
+	if len(mismatches) == 0 {
+		return nil
+	}
+	message := fmt.Sprintf("the object does not match at %s:", pluralize(len(mismatches), "path"))
+	for _, m := range mismatches {
+		message += fmt.Sprintf("\n  %s\n    expected: %s\n    actual  : %s", m.path, m.expected, m.actual)
+	}
+	return fmt.Errorf("%s", message)
+}
+
+// objectMismatch is a path of the object whose value doesn't match the subset.
+type objectMismatch struct {
+	path     string
+	expected string
+	actual   string
+}
+
+const (
+	missing = "<missing>"
+	// behindNilPointer stands for a field promoted from an embedded pointer that is
+	// nil, so the field has no value to match.
+	behindNilPointer = "<missing: behind a nil embedded pointer>"
+)
+
+// matchValue matches the value of the subset at path against the value of the
+// object there, and returns the mismatches found.
+func matchValue(expected, actual reflect.Value, path string) []objectMismatch {
+	if matcher, ok := internal.AsAsymmetricMatcher(expected); ok {
+		var value any
+		if actual.IsValid() && actual.CanInterface() {
+			value = actual.Interface()
+		}
+		if matcher.Matches(value) {
+			return nil
+		}
+		return []objectMismatch{{path, formatValue(expected), formatValue(actual)}}
+	}
+	expected, actual = indirect(expected), indirect(actual)
+	if expected.IsValid() && actual.IsValid() && expected.Type() == actual.Type() &&
+		internal.ObjectsAreEqual(expected.Interface(), actual.Interface()) {
+		return nil
+	}
+
+	switch {
+	case isObject(expected) && hasFields(expected):
+		if !isObject(actual) {
+			return []objectMismatch{{path, formatValue(expected), formatValue(actual)}}
+		}
+		return matchObject(expected, actual, path)
+	case (expected.Kind() == reflect.Slice || expected.Kind() == reflect.Array) &&
+		(actual.Kind() == reflect.Slice || actual.Kind() == reflect.Array):
+		if expected.Len() != actual.Len() {
+			return []objectMismatch{{
+				path,
+				fmt.Sprintf("%s with %s", formatValue(expected), pluralize(expected.Len(), "element")),
+				fmt.Sprintf("%s with %s", formatValue(actual), pluralize(actual.Len(), "element")),
+			}}
+		}
+		mismatches := []objectMismatch{}
+		for i := 0; i < expected.Len(); i++ {
+			mismatches = append(mismatches, matchValue(expected.Index(i), actual.Index(i), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return mismatches
+	}
+
+	if !expected.IsValid() || !actual.IsValid() {
+		if isNil(expected) && isNil(actual) {
+			return nil
+		}
+		return []objectMismatch{{path, formatValue(expected), formatValue(actual)}}
+	}
+	if !internal.ObjectsAreEqual(expected.Interface(), actual.Interface()) {
+		return []objectMismatch{{path, formatValue(expected), formatValue(actual)}}
+	}
+	return nil
+}
+
+// matchObject matches the fields or keys of the expected struct or map against
+// those of the actual one.
+func matchObject(expected, actual reflect.Value, path string) []objectMismatch {
+	mismatches := []objectMismatch{}
+	if expected.Kind() == reflect.Map {
+		keys := expected.MapKeys()
+		internal.SortValues(keys)
+		for _, key := range keys {
+			keyPath := path + mapKeyPath(key, actual)
+			actualValue, absence := lookup(actual, key)
+			if absence != "" {
+				mismatches = append(mismatches, objectMismatch{keyPath, formatValue(expected.MapIndex(key)), absence})
+				continue
+			}
+			mismatches = append(mismatches, matchValue(expected.MapIndex(key), actualValue, keyPath)...)
+		}
+		return mismatches
+	}
+	for i := 0; i < expected.NumField(); i++ {
+		field := expected.Type().Field(i)
+		if !field.IsExported() || expected.Field(i).IsZero() {
+			continue
+		}
+		fieldPath := path + "." + field.Name
+		actualValue, absence := lookup(actual, reflect.ValueOf(field.Name))
+		if absence != "" {
+			mismatches = append(mismatches, objectMismatch{fieldPath, formatValue(expected.Field(i)), absence})
+			continue
+		}
+		mismatches = append(mismatches, matchValue(expected.Field(i), actualValue, fieldPath)...)
+	}
+	return mismatches
+}
+
+// lookup returns the value of a field of a struct, or of a key of a map. When
+// there is none, it returns how to show its absence in place of the actual value.
+func lookup(object reflect.Value, key reflect.Value) (reflect.Value, string) {
+	if object.Kind() == reflect.Struct {
+		if key.Kind() != reflect.String {
+			return reflect.Value{}, missing
+		}
+		field, ok := object.Type().FieldByName(key.String())
+		if !ok || !field.IsExported() {
+			return reflect.Value{}, missing
+		}
+		value, err := object.FieldByIndexErr(field.Index)
+		if err != nil {
+			return reflect.Value{}, behindNilPointer
+		}
+		return value, ""
+	}
+	if !key.Type().AssignableTo(object.Type().Key()) {
+		if !key.Type().ConvertibleTo(object.Type().Key()) || key.Kind() != object.Type().Key().Kind() {
+			return reflect.Value{}, missing
+		}
+		key = key.Convert(object.Type().Key())
+	}
+	value := object.MapIndex(key)
+	if !value.IsValid() {
+		return value, missing
+	}
+	return value, ""
+}
+
+// mapKeyPath is the path segment of a key of the subset: a field name for a
+// struct object, an index expression for a map one.
+func mapKeyPath(key, object reflect.Value) string {
+	if object.Kind() == reflect.Struct && key.Kind() == reflect.String {
+		return "." + key.String()
+	}
+	return fmt.Sprintf("[%#v]", key.Interface())
+}
+
+// indirect unwraps interfaces and follows non-nil pointers.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.IsValid() && v.Kind() == reflect.Interface {
+		return reflect.Value{}
+	}
+	return v
+}
+
+// isNil reports whether v is nil: a nil interface, or a nil pointer, map, slice,
+// channel or function.
+func isNil(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	}
+	return false
+}
+
+func isObject(v reflect.Value) bool {
+	return v.Kind() == reflect.Struct || v.Kind() == reflect.Map
+}
+
+// hasFields tells objects matched field by field from values compared as a
+// whole, such as a time.Time, which has no exported fields.
+func hasFields(v reflect.Value) bool {
+	if v.Kind() == reflect.Map {
+		return true
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	if !v.CanInterface() {
+		return v.String()
+	}
+	return internal.FormatValue(v.Interface())
+}
+
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
@@ -10,28 +10,52 @@ import (
 //
 //	ToPanic(func() { panic("boom") })
 func ToPanic(fn any) error {
+	fnValue, err := noArgFunction(fn, "ToPanic")
+	if err != nil {
+		return err
+	}
+	if call := callFunction(fnValue); !call.panicked {
+		return fmt.Errorf("the function should have panicked, but it did not")
+	}
+	return nil
+}
+
+// noArgFunction returns fn, if it is a function that takes no arguments, for the
+// matcher with the given name.
+func noArgFunction(fn any, matcher string) (reflect.Value, error) {
 	if fn == nil {
-		return fmt.Errorf("%#v is not a function", fn)
+		return reflect.Value{}, fmt.Errorf("%#v is not a function", fn)
 	}
 	fnValue := reflect.ValueOf(fn)
 	if fnValue.Kind() != reflect.Func {
-		return fmt.Errorf("%#v is not a function", fn)
+		return reflect.Value{}, fmt.Errorf("%#v is not a function", fn)
 	}
 	if fnValue.Type().NumIn() != 0 {
-		return fmt.Errorf("the function passed to ToPanic must take no arguments")
+		return reflect.Value{}, fmt.Errorf("the function passed to %s must take no arguments", matcher)
 	}
+	return fnValue, nil
+}
 
+// functionCall is the outcome of calling a function: what it returned, or what
+// it panicked with.
+type functionCall struct {
+	results   []reflect.Value
+	panicked  bool
+	recovered any
+}
+
+// callFunction calls a function that takes no arguments, recovering from its
+// panic.
+func callFunction(fnValue reflect.Value) (call functionCall) {
 	// Infer the panic from the call not completing, rather than from the
 	// recovered value — that way panic(nil) counts as a panic on every Go version.
-	didPanic := true
-	func() {
-		defer func() { recover() }()
-		fnValue.Call(nil)
-		didPanic = false
+	call.panicked = true
+	defer func() {
+		if call.panicked {
+			call.recovered = recover()
+		}
 	}()
-
-	if !didPanic {
-		return fmt.Errorf("the function should have panicked, but it did not")
-	}
-	return nil
+	call.results = fnValue.Call(nil)
+	call.panicked = false
+	return call
 }
@@ -0,0 +1,36 @@
+package assertions
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToPanicMatching asserts that the given argument is a no-argument function that
+// panics with a value whose message matches the regular expression pattern. The
+// message of an error is its Error(), of a fmt.Stringer its String(), and of any
+// other value what fmt.Sprint makes of it.
+//
+//	ToPanicMatching(func() { panic(fmt.Errorf("index %d out of range", 3)) }, `out of range$`)
+func ToPanicMatching(fn any, pattern string) error {
+	fnValue, err := noArgFunction(fn, "ToPanicMatching")
+	if err != nil {
+		return err
+	}
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid regex pattern %q: %s", pattern, err)
+	}
+	call := callFunction(fnValue)
+	if !call.panicked {
+		return fmt.Errorf("the function should have panicked with a value matching %q, but it did not panic", pattern)
+	}
+	// fmt.Sprint prefers Error() to String(), and takes a string as it is.
+	message := fmt.Sprint(call.recovered)
+	if !regex.MatchString(message) {
+		return fmt.Errorf("the function panicked with %s, whose message %q does not match %q",
+			internal.FormatValue(call.recovered), message, pattern)
+	}
+	return nil
+}
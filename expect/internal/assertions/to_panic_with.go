@@ -0,0 +1,34 @@
+package assertions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToPanicWith asserts that the given argument is a no-argument function that
+// panics with the given value, compared as ToEqual does. An error value also
+// matches a panic with an error that wraps it, as reported by errors.Is.
+//
+//	ToPanicWith(func() { panic("boom") }, "boom")
+func ToPanicWith(fn, value any) error {
+	fnValue, err := noArgFunction(fn, "ToPanicWith")
+	if err != nil {
+		return err
+	}
+	call := callFunction(fnValue)
+	if !call.panicked {
+		return fmt.Errorf("the function should have panicked with %s, but it did not panic", internal.FormatValue(value))
+	}
+	if internal.ObjectsAreEqual(value, call.recovered) {
+		return nil
+	}
+	if target, ok := value.(error); ok {
+		if recoveredErr, ok := call.recovered.(error); ok && errors.Is(recoveredErr, target) {
+			return nil
+		}
+	}
+	return fmt.Errorf("the function panicked with %s, not with %s",
+		internal.FormatValue(call.recovered), internal.FormatValue(value))
+}
@@ -0,0 +1,31 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToReceive asserts that a value can be received from the given channel within
+// the given duration. The received value is passed to onReceive, which can check
+// it: ToReceive fails with the error onReceive returns, if any.
+//
+//	ToReceive(results, time.Second, func(received any) error { return nil })
+func ToReceive(ch any, within time.Duration, onReceive func(received any) error) error {
+	chValue, err := receivableChannel(ch)
+	if err != nil {
+		return err
+	}
+	value, received, closed := receiveWithin(chValue, within)
+	if closed {
+		return fmt.Errorf("%s is closed, so nothing was received from it", internal.DescribeChannel(chValue))
+	}
+	if !received {
+		return fmt.Errorf("nothing was received from %s within %v", internal.DescribeChannel(chValue), within)
+	}
+	if err := onReceive(value.Interface()); err != nil {
+		return fmt.Errorf("received %#v from %s, but:\n%w", value.Interface(), internal.DescribeChannel(chValue), err)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package assertions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToReceiveNothing asserts that no value is received from the given channel
+// within the given duration. A closed channel delivers no value, so it passes.
+//
+//	ToReceiveNothing(results, 100*time.Millisecond)
+func ToReceiveNothing(ch any, within time.Duration) error {
+	chValue, err := receivableChannel(ch)
+	if err != nil {
+		return err
+	}
+	if value, received, _ := receiveWithin(chValue, within); received {
+		return fmt.Errorf("expected nothing to be received within %v, but received %#v from %s", within, value.Interface(), internal.DescribeChannel(chValue))
+	}
+	return nil
+}
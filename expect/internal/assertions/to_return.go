@@ -0,0 +1,67 @@
+package assertions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToReturn asserts that the given argument is a no-argument function that
+// returns the given values, compared as ToEqual does. For a function whose last
+// result is an error, the error may be left out of values: it must then be nil.
+//
+//	ToReturn(func() (int, error) { return strconv.Atoi("42") }, 42)
+//	ToReturn(func() (string, bool) { return lookup("a") }, "x", true)
+func ToReturn(fn any, values ...any) error {
+	fnValue, err := noArgFunction(fn, "ToReturn")
+	if err != nil {
+		return err
+	}
+	fnType := fnValue.Type()
+	numOut := fnType.NumOut()
+	returnsError := numOut > 0 && fnType.Out(numOut-1) == errorType
+	if len(values) != numOut && !(returnsError && len(values) == numOut-1) {
+		return fmt.Errorf("the function returns %d values, but %d were given to compare them with", numOut, len(values))
+	}
+
+	call := callFunction(fnValue)
+	if call.panicked {
+		return fmt.Errorf("the function should have returned, but it panicked with %s", internal.FormatValue(call.recovered))
+	}
+	results := make([]any, len(call.results))
+	for i, result := range call.results {
+		results[i] = result.Interface()
+	}
+	if len(values) < numOut {
+		if returnedErr, _ := results[numOut-1].(error); returnedErr != nil {
+			return fmt.Errorf("the function returned the error %q\n%s", returnedErr.Error(), internal.FormatErrorChain(returnedErr))
+		}
+		results = results[:len(values)]
+	}
+
+	var differences []string
+	for i := range values {
+		if !internal.ObjectsAreEqual(values[i], results[i]) {
+			expected, actual := internal.FormatUnequalValues(values[i], results[i])
+			differences = append(differences, fmt.Sprintf("  result %d: expected %s, actual %s", i, expected, actual))
+		}
+	}
+	if len(differences) > 0 {
+		return fmt.Errorf("the function returned %s, not %s:\n%s", formatResults(results), formatResults(values), strings.Join(differences, "\n"))
+	}
+	return nil
+}
+
+// formatResults formats the values returned by a function, e.g. (1, "a").
+func formatResults(results []any) string {
+	formatted := make([]string, len(results))
+	for i, result := range results {
+		if result == nil {
+			formatted[i] = "nil"
+		} else {
+			formatted[i] = internal.FormatValue(result)
+		}
+	}
+	return "(" + strings.Join(formatted, ", ") + ")"
+}
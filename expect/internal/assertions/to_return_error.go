@@ -0,0 +1,53 @@
+package assertions
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+// ToReturnError asserts that the given argument is a no-argument function whose
+// last result, an error, is not nil. The error may be further checked against
+// expected: an error it must match (see ToMatchError), or a substring or a
+// *regexp.Regexp its message must contain or match (see ToHaveErrorMessage).
+//
+//	ToReturnError(func() (int, error) { return strconv.Atoi("x") })
+//	ToReturnError(func() error { return os.Remove("nope") }, fs.ErrNotExist)
+func ToReturnError(fn any, expected ...any) error {
+	fnValue, err := noArgFunction(fn, "ToReturnError")
+	if err != nil {
+		return err
+	}
+	fnType := fnValue.Type()
+	numOut := fnType.NumOut()
+	if numOut == 0 || fnType.Out(numOut-1) != errorType {
+		return fmt.Errorf("the function passed to ToReturnError must return an error as its last result")
+	}
+	if len(expected) > 1 {
+		return fmt.Errorf("ToReturnError takes at most one error, substring or *regexp.Regexp to check the error against, but got %d", len(expected))
+	}
+
+	call := callFunction(fnValue)
+	if call.panicked {
+		return fmt.Errorf("the function should have returned an error, but it panicked with %s", internal.FormatValue(call.recovered))
+	}
+	returnedErr, _ := call.results[numOut-1].Interface().(error)
+	if returnedErr == nil {
+		results := make([]any, numOut)
+		for i, result := range call.results {
+			results[i] = result.Interface()
+		}
+		return fmt.Errorf("the function should have returned an error, but it returned %s", formatResults(results))
+	}
+	if len(expected) == 0 {
+		return nil
+	}
+	switch expected := expected[0].(type) {
+	case error:
+		return ToMatchError(returnedErr, expected)
+	case string, *regexp.Regexp:
+		return ToHaveErrorMessage(returnedErr, expected)
+	}
+	return fmt.Errorf("expected an error, a substring or a *regexp.Regexp to check the error against, but got %#v", expected[0])
+}
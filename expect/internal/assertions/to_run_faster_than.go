@@ -0,0 +1,45 @@
+package assertions
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ToRunFasterThan asserts that the given argument is a func() whose median run
+// takes less than d, over the given number of timed runs. The function is run
+// iterations/10 times more first, at least once, to warm up caches and pools;
+// those runs are not timed. The median, unlike the mean, is not thrown off by
+// the odd run slowed down by the garbage collector or the scheduler.
+//
+//	ToRunFasterThan(func() { cache.Get("key") }, time.Microsecond, 1000)
+func ToRunFasterThan(fn any, d time.Duration, iterations int) error {
+	f, ok := fn.(func())
+	if !ok {
+		return fmt.Errorf("%#v is not a func()", fn)
+	}
+	if iterations < 1 {
+		return fmt.Errorf("the function must be run at least once, but iterations is %d", iterations)
+	}
+
+	for range max(iterations/10, 1) {
+		f()
+	}
+	durations := make([]time.Duration, iterations)
+	for i := range durations {
+		start := time.Now()
+		f()
+		durations[i] = time.Since(start)
+	}
+	slices.Sort(durations)
+	median := durations[iterations/2]
+	if iterations%2 == 0 {
+		median = (durations[iterations/2-1] + durations[iterations/2]) / 2
+	}
+
+	if median >= d {
+		return fmt.Errorf("the function took %s per run, not less than %s (median of %d runs; fastest %s, slowest %s)",
+			roundDuration(median), d, iterations, roundDuration(durations[0]), roundDuration(durations[iterations-1]))
+	}
+	return nil
+}
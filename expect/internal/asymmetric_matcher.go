@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// AsymmetricMatcher is a placeholder embedded in an expected value, such as the
+// Any[int]() of []any{Any[int](), "b"}: ObjectsAreEqual compares it with the
+// value at the same place by calling match, rather than by equality.
+type AsymmetricMatcher struct {
+	description string
+	match       func(actual any) bool
+}
+
+func NewAsymmetricMatcher(description string, match func(actual any) bool) AsymmetricMatcher {
+	return AsymmetricMatcher{description: description, match: match}
+}
+
+func (m AsymmetricMatcher) Matches(actual any) bool {
+	return m.match(actual)
+}
+
+// GoString shows the placeholder as its description in failure messages.
+func (m AsymmetricMatcher) GoString() string {
+	return m.description
+}
+
+func (m AsymmetricMatcher) String() string {
+	return m.description
+}
+
+var asymmetricMatcherType = reflect.TypeOf(AsymmetricMatcher{})
+
+// AsAsymmetricMatcher returns the placeholder v holds, directly or in an
+// interface, if any.
+func AsAsymmetricMatcher(v reflect.Value) (AsymmetricMatcher, bool) {
+	if v.IsValid() && v.Kind() == reflect.Interface {
+		v, _ = exported(v)
+		if !v.IsNil() {
+			v = v.Elem()
+		}
+	}
+	if !v.IsValid() || v.Type() != asymmetricMatcherType {
+		return AsymmetricMatcher{}, false
+	}
+	matcher, ok := interfaceOf(v)
+	if !ok {
+		return AsymmetricMatcher{}, false
+	}
+	return matcher.(AsymmetricMatcher), true
+}
+
+// containsAsymmetricMatcher reports whether a placeholder is embedded anywhere in
+// v. visited guards against cycles through pointers.
+func containsAsymmetricMatcher(v reflect.Value, visited map[uintptr]bool) bool {
+	if !v.IsValid() {
+		return false
+	}
+	if v.Type() == asymmetricMatcherType {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		return !v.IsNil() && containsAsymmetricMatcher(v.Elem(), visited)
+	case reflect.Pointer:
+		if v.IsNil() || visited[v.Pointer()] {
+			return false
+		}
+		visited[v.Pointer()] = true
+		return containsAsymmetricMatcher(v.Elem(), visited)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if containsAsymmetricMatcher(v.Field(i), visited) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if containsAsymmetricMatcher(v.Index(i), visited) {
+				return true
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if containsAsymmetricMatcher(iter.Value(), visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// interfaceOf returns the value v holds, including one read through an
+// unexported struct field, which reflect otherwise refuses to hand out. That
+// needs v to be addressable; ok is false when it isn't.
+func interfaceOf(v reflect.Value) (value any, ok bool) {
+	if v.CanInterface() {
+		return v.Interface(), true
+	}
+	if !v.CanAddr() {
+		return nil, false
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem().Interface(), true
+}
+
+// addressable returns an addressable copy of the value, so the unexported
+// fields within it can be read with interfaceOf.
+func addressable(value any) reflect.Value {
+	v := reflect.New(reflect.TypeOf(value)).Elem()
+	v.Set(reflect.ValueOf(value))
+	return v
+}
+
+// exported returns v in a form that can be set into another value, which those
+// read through unexported fields can't be unless they are addressable.
+func exported(v reflect.Value) (reflect.Value, bool) {
+	if v.CanInterface() {
+		return v, true
+	}
+	if !v.CanAddr() {
+		return v, false
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem(), true
+}
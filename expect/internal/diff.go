@@ -2,29 +2,8 @@ package internal
 
 import (
 	"reflect"
-	"time"
-
-	"github.com/davecgh/go-spew/spew"
-	"github.com/pmezard/go-difflib/difflib"
 )
 
-var spewConfigStringerEnabled = spew.ConfigState{
-	Indent:                  " ",
-	DisablePointerAddresses: true,
-	DisableCapacities:       true,
-	SortKeys:                true,
-	MaxDepth:                10,
-}
-
-var spewConfig = spew.ConfigState{
-	Indent:                  " ",
-	DisablePointerAddresses: true,
-	DisableCapacities:       true,
-	SortKeys:                true,
-	DisableMethods:          true,
-	MaxDepth:                10,
-}
-
 func typeAndKind(v any) (reflect.Type, reflect.Kind) {
 	t := reflect.TypeOf(v)
 	k := t.Kind()
@@ -36,17 +15,16 @@ func typeAndKind(v any) (reflect.Type, reflect.Kind) {
 	return t, k
 }
 
-// Sdump returns a string with the passed arguments formatted exactly the same
-// as Dump.
-// func Sdump(c *spew.ConfigState, a ...any) string {
-// 	var buf bytes.Buffer
-// 	fdump(c, &buf, a...)
-// 	return buf.String()
-// }
-
-// diff returns a diff of both values as long as both are of the same type and
+// Diff returns a diff of both values as long as both are of the same type and
 // are a struct, map, slice, array or string. Otherwise it returns an empty string.
+// Strings are diffed line by line, and within the lines that changed; the other
+// values get a structural diff, listing the paths at which they differ.
 func Diff(expected any, actual any) string {
+	return DiffWith(expected, actual, EqualOptions{})
+}
+
+// DiffWith is Diff, leaving out what options tell ObjectsAreEqualWith to ignore.
+func DiffWith(expected any, actual any, options EqualOptions) string {
 	if expected == nil || actual == nil {
 		return ""
 	}
@@ -58,33 +36,20 @@ func Diff(expected any, actual any) string {
 		return ""
 	}
 
-	if ek != reflect.Struct && ek != reflect.Map && ek != reflect.Slice && ek != reflect.Array && ek != reflect.String {
+	if ek == reflect.String {
+		if reflect.TypeOf(expected).Kind() != reflect.String {
+			return ""
+		}
+		return "\n\nDiff:\n" + StringDiff(reflect.ValueOf(expected).String(), reflect.ValueOf(actual).String())
+	}
+	if ek != reflect.Struct && ek != reflect.Map && ek != reflect.Slice && ek != reflect.Array {
 		return ""
 	}
 
-	var e, a string
-
-	switch et {
-	case reflect.TypeOf(""):
-		e = reflect.ValueOf(expected).String()
-		a = reflect.ValueOf(actual).String()
-	case reflect.TypeOf(time.Time{}):
-		e = spewConfigStringerEnabled.Sdump(expected)
-		a = spewConfigStringerEnabled.Sdump(actual)
-	default:
-		e = spewConfig.Sdump(expected)
-		a = spewConfig.Sdump(actual)
+	diff := newStructuralDiff(options)
+	diff.walk(addressable(expected), addressable(actual), "", "")
+	if len(diff.differences) == 0 {
+		return ""
 	}
-
-	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
-		A:        difflib.SplitLines(e),
-		B:        difflib.SplitLines(a),
-		FromFile: "Expected",
-		FromDate: "",
-		ToFile:   "Actual",
-		ToDate:   "",
-		Context:  1,
-	})
-
-	return "\n\nDiff:\n" + diff
+	return "\n\nDiff:\n" + diff.String()
 }
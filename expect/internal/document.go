@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DocumentFormat is a text format whose documents can be compared semantically:
+// parsed into a tree of maps, slices, strings, numbers, booleans and nils that
+// is the same for two equivalent documents, whatever their key order or
+// whitespace.
+type DocumentFormat struct {
+	Name      string
+	unmarshal func(data []byte) (any, error)
+	marshal   func(v any) ([]byte, error)
+}
+
+var (
+	JSON = DocumentFormat{Name: "JSON", unmarshal: unmarshalJSON, marshal: json.Marshal}
+	YAML = DocumentFormat{Name: "YAML", unmarshal: unmarshalYAML, marshal: yaml.Marshal}
+	XML  = DocumentFormat{Name: "XML", unmarshal: unmarshalXML, marshal: xml.Marshal}
+)
+
+// ParseDocument parses a document given as a string or a []byte, or marshals any
+// other value in the format first, and returns its tree.
+func (f DocumentFormat) ParseDocument(document any) (any, error) {
+	var data []byte
+	switch d := document.(type) {
+	case string:
+		data = []byte(d)
+	case []byte:
+		data = d
+	case json.RawMessage:
+		data = d
+	default:
+		marshalled, err := f.marshal(document)
+		if err != nil {
+			return nil, fmt.Errorf("%#v can't be marshalled to %s: %w", document, f.Name, err)
+		}
+		data = marshalled
+	}
+	tree, err := f.unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not valid %s: %w", truncate(string(data), maxDiffValueLength), f.Name, err)
+	}
+	return tree, nil
+}
+
+// documentNumber is a number of a document tree, in a canonical decimal form,
+// so that 1, 1.0 and 1e0 are equal. It keeps the digits of numbers too large
+// for a float64.
+type documentNumber string
+
+func (n documentNumber) GoString() string {
+	return string(n)
+}
+
+// newDocumentNumber returns the canonical form of the number written as text.
+func newDocumentNumber(text string) documentNumber {
+	r, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return documentNumber(text)
+	}
+	if r.IsInt() {
+		return documentNumber(r.Num().String())
+	}
+	// The number is a finite decimal: scale it by 10 until it is an integer to
+	// know how many decimals it has.
+	decimals := 0
+	for scaled := new(big.Rat).Set(r); !scaled.IsInt() && decimals < 1000; decimals++ {
+		scaled.Mul(scaled, big.NewRat(10, 1))
+	}
+	return documentNumber(r.FloatString(decimals))
+}
+
+func unmarshalJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var tree any
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the top-level value")
+	}
+	return normalizeDocument(tree), nil
+}
+
+func unmarshalYAML(data []byte) (any, error) {
+	var tree any
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	return normalizeDocument(tree), nil
+}
+
+// normalizeDocument turns the values decoded from JSON or YAML into a document
+// tree: maps with string keys, []any, strings, documentNumbers, booleans and
+// nils.
+func normalizeDocument(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		tree := make(map[string]any, len(v))
+		for key, value := range v {
+			tree[key] = normalizeDocument(value)
+		}
+		return tree
+	case map[any]any:
+		tree := make(map[string]any, len(v))
+		for key, value := range v {
+			tree[fmt.Sprint(key)] = normalizeDocument(value)
+		}
+		return tree
+	case []any:
+		tree := make([]any, len(v))
+		for i, value := range v {
+			tree[i] = normalizeDocument(value)
+		}
+		return tree
+	case json.Number:
+		return newDocumentNumber(string(v))
+	case int:
+		return newDocumentNumber(strconv.Itoa(v))
+	case int64:
+		return newDocumentNumber(strconv.FormatInt(v, 10))
+	case uint64:
+		return newDocumentNumber(strconv.FormatUint(v, 10))
+	case float64:
+		return newDocumentNumber(strconv.FormatFloat(v, 'f', -1, 64))
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	}
+	return value
+}
+
+// XMLElement is an element of an XML document tree. Its attributes are
+// unordered, its children ordered; comments, processing instructions and the
+// whitespace around elements are left out.
+type XMLElement struct {
+	Name       string
+	Attributes map[string]string
+	Text       string
+	Children   []XMLElement
+}
+
+func unmarshalXML(data []byte) (any, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var stack []*XMLElement
+	var root *XMLElement
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &XMLElement{Name: xmlName(t.Name)}
+			for _, attr := range t.Attr {
+				if element.Attributes == nil {
+					element.Attributes = map[string]string{}
+				}
+				element.Attributes[xmlName(attr.Name)] = attr.Value
+			}
+			stack = append(stack, element)
+		case xml.EndElement:
+			element := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			element.Text = strings.TrimSpace(element.Text)
+			if len(stack) == 0 {
+				root = element
+			} else {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, *element)
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text += string(t)
+			} else if strings.TrimSpace(string(t)) != "" {
+				return nil, errors.New("text outside of the root element")
+			}
+		}
+	}
+	if root == nil {
+		return nil, errors.New("no root element")
+	}
+	return *root, nil
+}
+
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"reflect"
+	"sync"
+)
+
+// EqualOptions adjust how ObjectsAreEqualWith compares two values. The zero
+// value compares as reflect.DeepEqual does.
+type EqualOptions struct {
+	// IgnoredFields are struct fields left out of the comparison, named either
+	// alone, to ignore the field in any struct, or by their path from the compared
+	// value, as in "Order.UpdatedAt".
+	IgnoredFields []string
+	// IgnoreUnexported leaves the unexported struct fields out of the comparison.
+	IgnoreUnexported bool
+	// Margin is how far apart two floats can be and still be equal.
+	Margin float64
+	// SortSlices are less functions, func(a, b T) bool, that sort the slices of T
+	// before they are compared, so that the order of their elements doesn't matter.
+	SortSlices []reflect.Value
+}
+
+func (o EqualOptions) isZero() bool {
+	return len(o.IgnoredFields) == 0 && !o.IgnoreUnexported && o.Margin == 0 && len(o.SortSlices) == 0
+}
+
+// ignoresField reports whether the struct field called name, at path, is left
+// out of the comparison.
+func (o EqualOptions) ignoresField(field reflect.StructField, path string) bool {
+	if o.IgnoreUnexported && !field.IsExported() {
+		return true
+	}
+	for _, ignored := range o.IgnoredFields {
+		if ignored == field.Name || ignored == path {
+			return true
+		}
+	}
+	return false
+}
+
+// lessFor returns the less function that sorts the slices of elemType, if any.
+func (o EqualOptions) lessFor(elemType reflect.Type) (reflect.Value, bool) {
+	for _, less := range o.SortSlices {
+		if elemType.AssignableTo(less.Type().In(0)) {
+			return less, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+var (
+	comparersMu sync.RWMutex
+	comparers   = map[reflect.Type]func(a, b any) bool{}
+)
+
+// RegisterComparer makes the values of typ compare with equal, wherever they are
+// found, instead of field by field.
+func RegisterComparer(typ reflect.Type, equal func(a, b any) bool) {
+	comparersMu.Lock()
+	defer comparersMu.Unlock()
+	comparers[typ] = equal
+}
+
+func comparerFor(typ reflect.Type) func(a, b any) bool {
+	comparersMu.RLock()
+	defer comparersMu.RUnlock()
+	return comparers[typ]
+}
+
+func hasComparers() bool {
+	comparersMu.RLock()
+	defer comparersMu.RUnlock()
+	return len(comparers) > 0
+}
@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatErrorChain lays out err and every error it wraps as an indented tree, one
+// error per line with its type and message. An error wrapping a single error
+// (Unwrap() error) has it on the next level; joined errors (Unwrap() []error) are
+// listed side by side on the next level.
+//
+//	error chain:
+//	  *fmt.wrapError "load config: open config.yml: no such file"
+//	    *fs.PathError "open config.yml: no such file"
+//	      syscall.Errno "no such file"
+func FormatErrorChain(err error) string {
+	lines := []string{"error chain:"}
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		indent := strings.Repeat("  ", depth)
+		if err == nil {
+			lines = append(lines, indent+"<nil>")
+			return
+		}
+		lines = append(lines, fmt.Sprintf("%s%T %q", indent, err, err.Error()))
+		switch wrapper := err.(type) {
+		case interface{ Unwrap() error }:
+			if wrapped := wrapper.Unwrap(); wrapped != nil {
+				walk(wrapped, depth+1)
+			}
+		case interface{ Unwrap() []error }:
+			for _, wrapped := range wrapper.Unwrap() {
+				walk(wrapped, depth+1)
+			}
+		}
+	}
+	walk(err, 1)
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// FormatValue formats a value for a failure message, as %#v does, except for
+// channels, contexts and errors, whose %#v (a pointer, a large struct) says
+// nothing useful: a channel is shown with its type and buffered length, a context
+// with whether it is done, and an error with its type and message.
+func FormatValue(v any) string {
+	if err, ok := v.(error); ok {
+		return fmt.Sprintf("%T(%q)", err, err.Error())
+	}
+	if ctx, ok := v.(context.Context); ok {
+		return fmt.Sprintf("context (%s)", DescribeContext(ctx))
+	}
+	if v != nil && reflect.TypeOf(v).Kind() == reflect.Chan {
+		return DescribeChannel(reflect.ValueOf(v))
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// DescribeChannel describes a channel by its type and buffered length, e.g.
+// "chan int (2 of 5 buffered)" or "chan int (unbuffered)".
+func DescribeChannel(ch reflect.Value) string {
+	if ch.IsNil() {
+		return fmt.Sprintf("nil %s", ch.Type())
+	}
+	if ch.Cap() == 0 {
+		return fmt.Sprintf("%s (unbuffered)", ch.Type())
+	}
+	return fmt.Sprintf("%s (%d of %d buffered)", ch.Type(), ch.Len(), ch.Cap())
+}
+
+// DescribeContext describes the state of a context: whether, and why, it is done.
+func DescribeContext(ctx context.Context) string {
+	switch err := ctx.Err(); {
+	case err == nil:
+		return "not done"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline exceeded"
+	default:
+		return fmt.Sprintf("done: %v", err)
+	}
+}
+
+// FormatTime formats a time for a failure message, in RFC 3339 with its
+// nanoseconds, leaving out the monotonic clock reading that %v shows.
+func FormatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
@@ -2,17 +2,41 @@ package internal
 
 import (
 	"bytes"
+	"math"
 	"reflect"
+	"sort"
 )
 
+// ObjectsAreEqual reports whether two values are deeply equal, as
+// reflect.DeepEqual does, except that []byte values are compared by content and
+// an AsymmetricMatcher embedded in either value matches the value at the same
+// place in the other one. The values of a type with a registered comparer are
+// compared with it.
 func ObjectsAreEqual(expected, actual any) bool {
+	return ObjectsAreEqualWith(expected, actual, EqualOptions{})
+}
+
+// ObjectsAreEqualWith is ObjectsAreEqual, adjusted by options.
+func ObjectsAreEqualWith(expected, actual any, options EqualOptions) bool {
+	if matcher, ok := expected.(AsymmetricMatcher); ok {
+		return matcher.Matches(actual)
+	}
+	if matcher, ok := actual.(AsymmetricMatcher); ok {
+		return matcher.Matches(expected)
+	}
 	if expected == nil || actual == nil {
 		return expected == actual
 	}
 
 	exp, ok := expected.([]byte)
 	if !ok {
-		return reflect.DeepEqual(expected, actual)
+		if options.isZero() && !hasComparers() &&
+			!containsAsymmetricMatcher(reflect.ValueOf(expected), map[uintptr]bool{}) &&
+			!containsAsymmetricMatcher(reflect.ValueOf(actual), map[uintptr]bool{}) {
+			return reflect.DeepEqual(expected, actual)
+		}
+		eq := equality{options: options, visited: map[[2]uintptr]bool{}}
+		return eq.deepEqual(addressable(expected), addressable(actual), "")
 	}
 
 	act, ok := actual.([]byte)
@@ -24,3 +48,157 @@ func ObjectsAreEqual(expected, actual any) bool {
 	}
 	return bytes.Equal(exp, act)
 }
+
+// equality compares two values as reflect.DeepEqual does, adjusted by options.
+// visited holds the pairs of pointers being compared, so cycles end.
+type equality struct {
+	options EqualOptions
+	visited map[[2]uintptr]bool
+}
+
+// deepEqual compares the values at path, checking the AsymmetricMatchers it meets
+// in either value against the value at the same place in the other one, and the
+// values with a registered comparer with it.
+func (eq *equality) deepEqual(expected, actual reflect.Value, path string) bool {
+	if matcher, ok := AsAsymmetricMatcher(expected); ok {
+		value, _ := interfaceOfOrNil(actual)
+		return matcher.Matches(value)
+	}
+	if matcher, ok := AsAsymmetricMatcher(actual); ok {
+		value, _ := interfaceOfOrNil(expected)
+		return matcher.Matches(value)
+	}
+	if !expected.IsValid() || !actual.IsValid() {
+		return expected.IsValid() == actual.IsValid()
+	}
+	if expected.Type() != actual.Type() {
+		return false
+	}
+	// Values read through unexported fields lose their addressability below
+	// interfaces, so they are made readable while it is still known.
+	expected, _ = exported(expected)
+	actual, _ = exported(actual)
+	if equal := comparerFor(expected.Type()); equal != nil && expected.CanInterface() && actual.CanInterface() {
+		return equal(expected.Interface(), actual.Interface())
+	}
+
+	switch expected.Kind() {
+	case reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			return expected.IsNil() == actual.IsNil()
+		}
+		return eq.deepEqual(expected.Elem(), actual.Elem(), path)
+	case reflect.Pointer:
+		if expected.Pointer() == actual.Pointer() {
+			return true
+		}
+		if expected.IsNil() || actual.IsNil() {
+			return false
+		}
+		pair := [2]uintptr{expected.Pointer(), actual.Pointer()}
+		if eq.visited[pair] {
+			return true
+		}
+		eq.visited[pair] = true
+		return eq.deepEqual(expected.Elem(), actual.Elem(), path)
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			field := expected.Type().Field(i)
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			if eq.options.ignoresField(field, fieldPath) {
+				continue
+			}
+			if !eq.deepEqual(expected.Field(i), actual.Field(i), fieldPath) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice:
+		if expected.IsNil() != actual.IsNil() {
+			return false
+		}
+		if less, ok := eq.options.lessFor(expected.Type().Elem()); ok && expected.Len() == actual.Len() {
+			expected, actual = sortedSlice(expected, less), sortedSlice(actual, less)
+		}
+		fallthrough
+	case reflect.Array:
+		if expected.Len() != actual.Len() {
+			return false
+		}
+		for i := 0; i < expected.Len(); i++ {
+			if !eq.deepEqual(expected.Index(i), actual.Index(i), path) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if expected.IsNil() != actual.IsNil() || expected.Len() != actual.Len() {
+			return false
+		}
+		iter := expected.MapRange()
+		for iter.Next() {
+			actualValue := actual.MapIndex(iter.Key())
+			if !actualValue.IsValid() || !eq.deepEqual(iter.Value(), actualValue, path) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		return expected.IsNil() && actual.IsNil()
+	case reflect.Float32, reflect.Float64:
+		if eq.options.Margin != 0 {
+			return math.Abs(expected.Float()-actual.Float()) <= eq.options.Margin
+		}
+	}
+
+	expectedValue, expectedOk := interfaceOf(expected)
+	actualValue, actualOk := interfaceOf(actual)
+	if !expectedOk || !actualOk {
+		return reflect.DeepEqual(valueOfBasicKind(expected), valueOfBasicKind(actual))
+	}
+	return reflect.DeepEqual(expectedValue, actualValue)
+}
+
+// interfaceOfOrNil is interfaceOf, with an invalid value read as nil.
+func interfaceOfOrNil(v reflect.Value) (any, bool) {
+	if !v.IsValid() {
+		return nil, true
+	}
+	return interfaceOf(v)
+}
+
+// valueOfBasicKind reads a value of a basic kind without Interface, which
+// reflect refuses for values read through unexported fields that aren't
+// addressable.
+func valueOfBasicKind(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return v.Complex()
+	case reflect.String:
+		return v.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return v.Pointer()
+	}
+	return nil
+}
+
+// sortedSlice returns a sorted copy of slice, ordered by less.
+func sortedSlice(slice, less reflect.Value) reflect.Value {
+	sorted := reflect.MakeSlice(slice.Type(), slice.Len(), slice.Len())
+	reflect.Copy(sorted, slice)
+	sort.SliceStable(sorted.Interface(), func(i, j int) bool {
+		return less.Call([]reflect.Value{sorted.Index(i), sorted.Index(j)})[0].Bool()
+	})
+	return sorted
+}
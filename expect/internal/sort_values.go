@@ -0,0 +1,15 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SortValues sorts map keys by their formatted value, so they are walked in a
+// stable order.
+func SortValues(values []reflect.Value) {
+	sort.Slice(values, func(i, j int) bool {
+		return fmt.Sprintf("%#v", values[i].Interface()) < fmt.Sprintf("%#v", values[j].Interface())
+	})
+}
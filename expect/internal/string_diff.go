@@ -0,0 +1,314 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/redjolr/goherent/terminal/ansi_escape"
+)
+
+// maxInlineDiffCells bounds the product of the token counts of two lines diffed
+// within the line. Longer lines are diffed by words, or not within the line.
+const maxInlineDiffCells = 1 << 22
+
+// StringDiff returns a unified diff of two strings, line by line. In the lines
+// that changed, the parts that differ are marked, as [-removed-] in expected and
+// {+added+} in actual, or coloured when colours are on. They are diffed by
+// characters or by words, whichever splits the changes in fewer parts. Tabs,
+// carriage returns, invisible characters, trailing spaces and the spaces in the
+// parts that differ are shown with markers, see VisibleString.
+func StringDiff(expected, actual string) string {
+	color := colorsEnabled()
+	a, b := difflib.SplitLines(expected), difflib.SplitLines(actual)
+
+	var out strings.Builder
+	out.WriteString("--- Expected\n+++ Actual\n")
+	for _, group := range difflib.NewMatcher(a, b).GetGroupedOpCodes(1) {
+		first, last := group[0], group[len(group)-1]
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", unifiedRange(first.I1, last.I2), unifiedRange(first.J1, last.J2))
+		for _, code := range group {
+			switch code.Tag {
+			case 'e':
+				for _, line := range a[code.I1:code.I2] {
+					out.WriteString(" " + renderLine([]segment{{editEqual, trimNewline(line)}}, color) + "\n")
+				}
+			case 'd':
+				for _, line := range a[code.I1:code.I2] {
+					out.WriteString("-" + renderLine([]segment{{editEqual, trimNewline(line)}}, color) + "\n")
+				}
+			case 'i':
+				for _, line := range b[code.J1:code.J2] {
+					out.WriteString("+" + renderLine([]segment{{editEqual, trimNewline(line)}}, color) + "\n")
+				}
+			case 'r':
+				removed, added := a[code.I1:code.I2], b[code.J1:code.J2]
+				var removedLines, addedLines []string
+				for i := range max(len(removed), len(added)) {
+					switch {
+					case i < len(removed) && i < len(added):
+						e, a := inlineDiff(trimNewline(removed[i]), trimNewline(added[i]))
+						removedLines = append(removedLines, renderLine(e, color))
+						addedLines = append(addedLines, renderLine(a, color))
+					case i < len(removed):
+						removedLines = append(removedLines, renderLine([]segment{{editEqual, trimNewline(removed[i])}}, color))
+					default:
+						addedLines = append(addedLines, renderLine([]segment{{editEqual, trimNewline(added[i])}}, color))
+					}
+				}
+				for _, line := range removedLines {
+					out.WriteString("-" + line + "\n")
+				}
+				for _, line := range addedLines {
+					out.WriteString("+" + line + "\n")
+				}
+			}
+		}
+	}
+	return out.String()
+}
+
+// unifiedRange formats a range of lines as a unified diff does.
+func unifiedRange(start, stop int) string {
+	beginning, length := start+1, stop-start
+	if length == 1 {
+		return fmt.Sprintf("%d", beginning)
+	}
+	if length == 0 {
+		beginning--
+	}
+	return fmt.Sprintf("%d,%d", beginning, length)
+}
+
+func trimNewline(line string) string {
+	return strings.TrimSuffix(line, "\n")
+}
+
+// segment is a part of a line in an inline diff: equal in both lines, only in
+// expected (editDelete) or only in actual (editInsert).
+type segment struct {
+	kind editKind
+	text string
+}
+
+// inlineDiff diffs two lines by characters or by words, whichever splits the
+// changes in fewer parts, and returns the segments of each line. Lines with
+// nothing in common are returned whole and unmarked, since all of them changed.
+func inlineDiff(expected, actual string) (expectedSegments, actualSegments []segment) {
+	byWords, wordsOk := diffTokens(words(expected), words(actual))
+	byChars, charsOk := diffTokens(chars(expected), chars(actual))
+	diff := byChars
+	if !charsOk || (wordsOk && changes(byWords) < changes(byChars)) {
+		diff = byWords
+	}
+	if (!charsOk && !wordsOk) || !hasEqualText(diff) {
+		return []segment{{editEqual, expected}}, []segment{{editEqual, actual}}
+	}
+
+	for _, s := range diff {
+		if s.kind != editInsert {
+			expectedSegments = append(expectedSegments, s)
+		}
+		if s.kind != editDelete {
+			actualSegments = append(actualSegments, s)
+		}
+	}
+	return expectedSegments, actualSegments
+}
+
+// diffTokens aligns two lists of tokens along their longest common subsequence
+// and returns the segments of both, merged. ok is false when they are too long
+// to be aligned.
+func diffTokens(a, b []string) (segments []segment, ok bool) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	middleA, middleB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(middleA)*len(middleB) > maxInlineDiffCells {
+		return nil, false
+	}
+
+	add := func(kind editKind, token string) {
+		if n := len(segments); n > 0 && segments[n-1].kind == kind {
+			segments[n-1].text += token
+			return
+		}
+		segments = append(segments, segment{kind, token})
+	}
+	for _, token := range a[:prefix] {
+		add(editEqual, token)
+	}
+
+	n, m := len(middleA), len(middleB)
+	common := make([][]int, n+1)
+	for i := range common {
+		common[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if middleA[i] == middleB[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+	// Deletions are taken before insertions, so a changed part reads as the
+	// removed text followed by the added one.
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && middleA[i] == middleB[j]:
+			add(editEqual, middleA[i])
+			i, j = i+1, j+1
+		case j == m || (i < n && common[i+1][j] >= common[i][j+1]):
+			add(editDelete, middleA[i])
+			i++
+		default:
+			add(editInsert, middleB[j])
+			j++
+		}
+	}
+
+	for _, token := range a[len(a)-suffix:] {
+		add(editEqual, token)
+	}
+	return segments, true
+}
+
+// changes counts the changed parts of an inline diff: the runs of segments
+// between two equal ones.
+func changes(segments []segment) int {
+	count, inChange := 0, false
+	for _, s := range segments {
+		if s.kind == editEqual {
+			inChange = false
+		} else if !inChange {
+			count++
+			inChange = true
+		}
+	}
+	return count
+}
+
+func hasEqualText(segments []segment) bool {
+	for _, s := range segments {
+		if s.kind == editEqual && strings.TrimSpace(s.text) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func chars(s string) []string {
+	tokens := make([]string, 0, len(s))
+	for _, r := range s {
+		tokens = append(tokens, string(r))
+	}
+	return tokens
+}
+
+// words splits s in words, runs of spaces, and single other characters.
+func words(s string) []string {
+	var tokens []string
+	runes := []rune(s)
+	for start := 0; start < len(runes); {
+		end := start + 1
+		switch {
+		case isWordRune(runes[start]):
+			for end < len(runes) && isWordRune(runes[end]) {
+				end++
+			}
+		case runes[start] == ' ':
+			for end < len(runes) && runes[end] == ' ' {
+				end++
+			}
+		}
+		tokens = append(tokens, string(runes[start:end]))
+		start = end
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// renderLine renders the segments of a line, marking or colouring those that
+// are not equal, with the characters that can't be seen replaced by markers.
+func renderLine(segments []segment, color bool) string {
+	// The spaces from trailingFrom on are trailing.
+	line := ""
+	for _, s := range segments {
+		line += s.text
+	}
+	trailingFrom := len([]rune(strings.TrimRight(line, " \t\r")))
+
+	var out strings.Builder
+	index := 0
+	for _, s := range segments {
+		var text strings.Builder
+		for _, r := range s.text {
+			text.WriteString(visibleRune(r, s.kind != editEqual || index >= trailingFrom))
+			index++
+		}
+		switch {
+		case s.kind == editEqual:
+			out.WriteString(text.String())
+		case color && s.kind == editDelete:
+			out.WriteString(ansi_escape.GREEN + text.String() + ansi_escape.COLOR_RESET)
+		case color:
+			out.WriteString(ansi_escape.RED + text.String() + ansi_escape.COLOR_RESET)
+		case s.kind == editDelete:
+			out.WriteString("[-" + text.String() + "-]")
+		default:
+			out.WriteString("{+" + text.String() + "+}")
+		}
+	}
+	return out.String()
+}
+
+// VisibleString returns s with the characters that can't be seen replaced by
+// markers: → for a tab, ␍ for a carriage return, · for a trailing space and
+// <U+200B> style codes for invisible characters, such as zero-width or
+// non-breaking spaces. Line feeds are kept.
+func VisibleString(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = renderLine([]segment{{editEqual, line}}, false)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NeedsVisibleString reports whether s has characters that VisibleString would
+// replace by markers.
+func NeedsVisibleString(s string) bool {
+	return VisibleString(s) != s
+}
+
+// visibleRune returns how r is shown in a diff. space tells whether a space is
+// shown with a marker.
+func visibleRune(r rune, space bool) string {
+	switch {
+	case r == ' ':
+		if space {
+			return "·"
+		}
+		return " "
+	case r == '\t':
+		return "→"
+	case r == '\r':
+		return "␍"
+	case r == '\n':
+		return "␊"
+	case unicode.IsControl(r) || unicode.Is(unicode.Cf, r) || unicode.IsSpace(r) || r == unicode.ReplacementChar:
+		return fmt.Sprintf("<U+%04X>", r)
+	}
+	return string(r)
+}
@@ -0,0 +1,507 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	goherent "github.com/redjolr/goherent/internal"
+	"github.com/redjolr/goherent/terminal/ansi_escape"
+)
+
+// maxDifferences is the number of differences a structural diff lists before it
+// only counts the rest.
+const maxDifferences = 30
+
+// maxAlignedElements bounds the length product of two slices aligned element by
+// element. Longer slices are compared index by index.
+const maxAlignedElements = 1 << 20
+
+// difference is a line of a structural diff: the path of a value and what
+// differs there.
+type difference struct {
+	path  string
+	text  string
+	equal bool
+}
+
+// structuralDiff walks two values side by side and lists, by path, the places
+// where they differ, such as:
+//
+//	.Orders[3].Items[0].Price: expected 10, actual 12
+//	.Tags["b"]: missing 2
+//	.Items[4]: unexpected "d"
+//
+// Map entries are walked in the order of their sorted keys. Slices are aligned
+// element by element, so an inserted or a deleted element shows as unexpected or
+// missing rather than shifting all the elements after it; the runs of equal
+// elements between them are then listed collapsed, by their indices in actual,
+// to show where the changes are. The values of types with a comparer, and of
+// types that describe themselves, such as time.Time or errors, are compared
+// whole.
+type structuralDiff struct {
+	equality    equality
+	differences []difference
+	visited     map[[2]uintptr]bool
+}
+
+func newStructuralDiff(options EqualOptions) *structuralDiff {
+	return &structuralDiff{
+		equality: equality{options: options, visited: map[[2]uintptr]bool{}},
+		visited:  map[[2]uintptr]bool{},
+	}
+}
+
+// String renders the differences, coloured when colours are on.
+func (d *structuralDiff) String() string {
+	color := colorsEnabled()
+	var out strings.Builder
+	shown := 0
+	for _, diff := range d.differences {
+		if !diff.equal && shown == maxDifferences {
+			break
+		}
+		path := diff.path
+		if path == "" {
+			path = "value"
+		}
+		text := diff.text
+		if color && diff.equal {
+			text = ansi_escape.DIM + text + ansi_escape.COLOR_RESET
+		}
+		out.WriteString(fmt.Sprintf("  %s: %s\n", path, text))
+		if !diff.equal {
+			shown++
+		}
+	}
+	if total := d.count(); total > shown {
+		out.WriteString(fmt.Sprintf("  ... and %d more differences\n", total-shown))
+	}
+	return out.String()
+}
+
+func (d *structuralDiff) count() int {
+	count := 0
+	for _, diff := range d.differences {
+		if !diff.equal {
+			count++
+		}
+	}
+	return count
+}
+
+func (d *structuralDiff) add(path, text string) {
+	d.differences = append(d.differences, difference{path: path, text: text})
+}
+
+// changed records a value that differs from the expected one.
+func (d *structuralDiff) changed(path string, expected, actual reflect.Value) {
+	e, a := formatDiffValue(expected), formatDiffValue(actual)
+	if e == a || (expected.IsValid() && actual.IsValid() && expected.Type() != actual.Type()) {
+		e, a = formatDiffType(expected)+"("+e+")", formatDiffType(actual)+"("+a+")"
+	}
+	d.add(path, fmt.Sprintf("expected %s, actual %s", colored(e, ansi_escape.GREEN), colored(a, ansi_escape.RED)))
+}
+
+func (d *structuralDiff) missing(path string, expected reflect.Value) {
+	d.add(path, "missing "+colored(formatDiffValue(expected), ansi_escape.GREEN))
+}
+
+func (d *structuralDiff) unexpected(path string, actual reflect.Value) {
+	d.add(path, "unexpected "+colored(formatDiffValue(actual), ansi_escape.RED))
+}
+
+func (d *structuralDiff) equalRun(path string, from, to int) {
+	text := fmt.Sprintf("%d equal elements", to-from)
+	if to-from == 1 {
+		text = "1 equal element"
+	}
+	d.differences = append(d.differences, difference{path: fmt.Sprintf("%s[%d:%d]", path, from, to), text: text, equal: true})
+}
+
+// walk records the differences between the values at path. fieldPath is the
+// path of struct field names only, against which ignored fields are matched.
+func (d *structuralDiff) walk(expected, actual reflect.Value, path, fieldPath string) {
+	if d.equality.deepEqual(expected, actual, fieldPath) {
+		return
+	}
+	if _, ok := AsAsymmetricMatcher(expected); ok {
+		d.changed(path, expected, actual)
+		return
+	}
+	if _, ok := AsAsymmetricMatcher(actual); ok {
+		d.changed(path, expected, actual)
+		return
+	}
+	if !expected.IsValid() || !actual.IsValid() || expected.Type() != actual.Type() {
+		d.changed(path, expected, actual)
+		return
+	}
+	expected, _ = exported(expected)
+	actual, _ = exported(actual)
+
+	switch expected.Kind() {
+	case reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			d.changed(path, expected, actual)
+			return
+		}
+		d.walk(expected.Elem(), actual.Elem(), path, fieldPath)
+	case reflect.Pointer:
+		if expected.IsNil() || actual.IsNil() || isWhole(expected.Type()) {
+			d.changed(path, expected, actual)
+			return
+		}
+		pair := [2]uintptr{expected.Pointer(), actual.Pointer()}
+		if d.visited[pair] {
+			return
+		}
+		d.visited[pair] = true
+		d.walk(expected.Elem(), actual.Elem(), path, fieldPath)
+	case reflect.Struct:
+		if isWhole(expected.Type()) {
+			d.changed(path, expected, actual)
+			return
+		}
+		for i := 0; i < expected.NumField(); i++ {
+			field := expected.Type().Field(i)
+			childFieldPath := field.Name
+			if fieldPath != "" {
+				childFieldPath = fieldPath + "." + field.Name
+			}
+			if d.equality.options.ignoresField(field, childFieldPath) {
+				continue
+			}
+			d.walk(expected.Field(i), actual.Field(i), path+"."+field.Name, childFieldPath)
+		}
+	case reflect.Map:
+		if expected.IsNil() != actual.IsNil() {
+			d.changed(path, expected, actual)
+			return
+		}
+		d.walkMap(expected, actual, path, fieldPath)
+	case reflect.Slice:
+		if expected.IsNil() != actual.IsNil() {
+			d.changed(path, expected, actual)
+			return
+		}
+		if less, ok := d.equality.options.lessFor(expected.Type().Elem()); ok {
+			expected, actual = sortedSlice(expected, less), sortedSlice(actual, less)
+		}
+		d.walkSlice(expected, actual, path, fieldPath)
+	case reflect.Array:
+		for i := 0; i < expected.Len(); i++ {
+			d.walk(expected.Index(i), actual.Index(i), fmt.Sprintf("%s[%d]", path, i), fieldPath)
+		}
+	default:
+		d.changed(path, expected, actual)
+	}
+}
+
+func (d *structuralDiff) walkMap(expected, actual reflect.Value, path, fieldPath string) {
+	keys := expected.MapKeys()
+	for _, key := range actual.MapKeys() {
+		if !expected.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	for i, key := range keys {
+		keys[i], _ = exported(key)
+	}
+	SortValues(keys)
+
+	for _, key := range keys {
+		keyPath := fmt.Sprintf("%s[%s]", path, formatDiffValue(key))
+		expectedValue, actualValue := expected.MapIndex(key), actual.MapIndex(key)
+		switch {
+		case !actualValue.IsValid():
+			d.missing(keyPath, expectedValue)
+		case !expectedValue.IsValid():
+			d.unexpected(keyPath, actualValue)
+		default:
+			d.walk(expectedValue, actualValue, keyPath, fieldPath)
+		}
+	}
+}
+
+// walkSlice aligns the elements of two slices, then walks the pairs of elements
+// that changed and records those missing from, or unexpected in, actual.
+// Missing elements are at their index in expected, the others at their index in
+// actual.
+func (d *structuralDiff) walkSlice(expected, actual reflect.Value, path, fieldPath string) {
+	if expected.Len() == actual.Len() || expected.Len()*actual.Len() > maxAlignedElements {
+		common := min(expected.Len(), actual.Len())
+		for i := 0; i < common; i++ {
+			d.walk(expected.Index(i), actual.Index(i), fmt.Sprintf("%s[%d]", path, i), fieldPath)
+		}
+		for i := common; i < expected.Len(); i++ {
+			d.missing(fmt.Sprintf("%s[%d]", path, i), expected.Index(i))
+		}
+		for i := common; i < actual.Len(); i++ {
+			d.unexpected(fmt.Sprintf("%s[%d]", path, i), actual.Index(i))
+		}
+		return
+	}
+
+	edits := d.align(expected, actual, fieldPath)
+	for start := 0; start < len(edits); {
+		end := start + 1
+		if edits[start].kind == editEqual {
+			for end < len(edits) && edits[end].kind == editEqual {
+				end++
+			}
+			d.equalRun(path, edits[start].actual, edits[end-1].actual+1)
+			start = end
+			continue
+		}
+
+		// A run of deletions and insertions: those that can be paired are elements
+		// that changed, the others are missing or unexpected.
+		for end < len(edits) && edits[end].kind != editEqual {
+			end++
+		}
+		var deleted, inserted []int
+		for _, e := range edits[start:end] {
+			if e.kind == editDelete {
+				deleted = append(deleted, e.expected)
+			} else {
+				inserted = append(inserted, e.actual)
+			}
+		}
+		for _, p := range d.pair(expected, actual, deleted, inserted, fieldPath) {
+			switch {
+			case p.kind == editEqual:
+				d.walk(expected.Index(p.expected), actual.Index(p.actual), fmt.Sprintf("%s[%d]", path, p.actual), fieldPath)
+			case p.kind == editDelete:
+				d.missing(fmt.Sprintf("%s[%d]", path, p.expected), expected.Index(p.expected))
+			default:
+				d.unexpected(fmt.Sprintf("%s[%d]", path, p.actual), actual.Index(p.actual))
+			}
+		}
+		start = end
+	}
+}
+
+// pair pairs the deleted elements of a slice with the inserted ones, in order.
+// Structs, maps and slices are paired with the most similar element, so a
+// changed field shows as such, and not as a whole element missing and another
+// unexpected; other elements are paired with the next inserted one. A pair is returned as an
+// editEqual edit, the elements left unpaired as deletions and insertions.
+func (d *structuralDiff) pair(expected, actual reflect.Value, deleted, inserted []int, fieldPath string) []edit {
+	var edits []edit
+	next := 0
+	for _, e := range deleted {
+		best := -1
+		if !isComposite(expected.Index(e)) {
+			if next < len(inserted) {
+				best = next
+			}
+		} else {
+			bestSimilarity := 0
+			for i := next; i < len(inserted); i++ {
+				if similarity := d.similarity(expected.Index(e), actual.Index(inserted[i]), fieldPath); similarity > bestSimilarity {
+					best, bestSimilarity = i, similarity
+				}
+			}
+		}
+		if best == -1 {
+			edits = append(edits, edit{kind: editDelete, expected: e})
+			continue
+		}
+		for ; next < best; next++ {
+			edits = append(edits, edit{kind: editInsert, actual: inserted[next]})
+		}
+		edits = append(edits, edit{editEqual, e, inserted[best]})
+		next = best + 1
+	}
+	for ; next < len(inserted); next++ {
+		edits = append(edits, edit{kind: editInsert, actual: inserted[next]})
+	}
+	return edits
+}
+
+// similarity counts the fields, keys or elements two values have in common.
+func (d *structuralDiff) similarity(expected, actual reflect.Value, fieldPath string) int {
+	for expected.IsValid() && actual.IsValid() && expected.Type() == actual.Type() &&
+		(expected.Kind() == reflect.Interface || expected.Kind() == reflect.Pointer) {
+		if expected.IsNil() || actual.IsNil() {
+			return 0
+		}
+		expected, actual = expected.Elem(), actual.Elem()
+	}
+	if !expected.IsValid() || !actual.IsValid() || expected.Type() != actual.Type() {
+		return 0
+	}
+
+	similarity := 0
+	switch expected.Kind() {
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			if d.equality.deepEqual(expected.Field(i), actual.Field(i), fieldPath) {
+				similarity++
+			}
+		}
+	case reflect.Map:
+		iter := expected.MapRange()
+		for iter.Next() {
+			if value := actual.MapIndex(iter.Key()); value.IsValid() && d.equality.deepEqual(iter.Value(), value, fieldPath) {
+				similarity++
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < min(expected.Len(), actual.Len()); i++ {
+			if d.equality.deepEqual(expected.Index(i), actual.Index(i), fieldPath) {
+				similarity++
+			}
+		}
+	}
+	return similarity
+}
+
+// isComposite reports whether v is a struct, a map or a slice, possibly behind
+// pointers or interfaces, that isn't compared whole.
+func isComposite(v reflect.Value) bool {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && !v.IsNil() {
+		if isWhole(v.Type()) {
+			return false
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return !isWhole(v.Type())
+	}
+	return false
+}
+
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+// edit is a step of the alignment of two slices: an element equal in both, one
+// only in expected, or one only in actual, at its index there.
+type edit struct {
+	kind     editKind
+	expected int
+	actual   int
+}
+
+// align aligns the elements of two slices along their longest common
+// subsequence.
+func (d *structuralDiff) align(expected, actual reflect.Value, fieldPath string) []edit {
+	n, m := expected.Len(), actual.Len()
+	equal := make([][]bool, n)
+	for i := range equal {
+		equal[i] = make([]bool, m)
+		for j := range equal[i] {
+			equal[i][j] = d.equality.deepEqual(expected.Index(i), actual.Index(j), fieldPath)
+		}
+	}
+	// common[i][j] is the length of the longest common subsequence of
+	// expected[i:] and actual[j:].
+	common := make([][]int, n+1)
+	for i := range common {
+		common[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if equal[i][j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	var edits []edit
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && equal[i][j]:
+			edits = append(edits, edit{editEqual, i, j})
+			i, j = i+1, j+1
+		case j == m || (i < n && common[i+1][j] >= common[i][j+1]):
+			edits = append(edits, edit{editDelete, i, j})
+			i++
+		default:
+			edits = append(edits, edit{editInsert, i, j})
+			j++
+		}
+	}
+	return edits
+}
+
+var (
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// isWhole reports whether the values of a struct type, or of a pointer type, are
+// compared whole rather than field by field: those with a comparer, and those
+// that describe themselves as a Stringer or an error, such as time.Time or
+// *big.Int.
+func isWhole(typ reflect.Type) bool {
+	return comparerFor(typ) != nil || typ.Implements(stringerType) || typ.Implements(errorType)
+}
+
+func formatDiffValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	value, ok := interfaceOf(v)
+	if !ok {
+		return fmt.Sprintf("%v", valueOfBasicKind(v))
+	}
+	return truncate(FormatValue(value), maxDiffValueLength)
+}
+
+// maxDiffValueLength is the length past which the values in a structural diff are
+// cut short.
+const maxDiffValueLength = 200
+
+// truncate shortens s to at most length bytes, cutting on a rune boundary so a
+// multi-byte character is never split.
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	for length > 0 && !utf8.RuneStart(s[length]) {
+		length--
+	}
+	return s[:length] + "<... truncated>"
+}
+
+func formatDiffType(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		return v.Elem().Type().String()
+	}
+	return v.Type().String()
+}
+
+// colorsEnabled reports whether diffs are coloured: when the runner asks for it,
+// unless NO_COLOR is set.
+func colorsEnabled() bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	return os.Getenv(goherent.COLOR_ENV) == "1"
+}
+
+func colored(text, color string) string {
+	if !colorsEnabled() {
+		return text
+	}
+	return color + text + ansi_escape.COLOR_RESET
+}
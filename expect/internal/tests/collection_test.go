@@ -0,0 +1,132 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+// seq and seq2 have the shapes of iter.Seq and iter.Seq2, which the collection
+// matchers recognise whatever the type is named.
+type seq[V any] func(yield func(V) bool)
+type seq2[K, V any] func(yield func(K, V) bool)
+
+func values[V any](elements ...V) seq[V] {
+	return func(yield func(V) bool) {
+		for _, element := range elements {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+func naturals(yield func(int) bool) {
+	for i := 0; yield(i); i++ {
+	}
+}
+
+// stack is a generic container with Len and All methods.
+type stack[T any] struct {
+	items []T
+}
+
+func (s *stack[T]) Len() int {
+	return len(s.items)
+}
+
+func (s *stack[T]) All() seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if !yield(len(s.items)-1-i, s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// bag only has an All method.
+type bag struct {
+	items []string
+}
+
+func (b bag) All() func(yield func(string) bool) {
+	return values(b.items...)
+}
+
+func TestCollections(t *testing.T) {
+	words := &stack[string]{items: []string{"a", "b"}}
+	var tests = []struct {
+		name           string
+		assertion      func() error
+		assertionFails bool
+	}{
+		{name: "ToContain with an iter.Seq", assertion: func() error { return assertions.ToContain(values(1, 2, 3), 2) }, assertionFails: false},
+		{name: "ToContain with an iter.Seq without the element", assertion: func() error { return assertions.ToContain(values(1, 2, 3), 4) }, assertionFails: true},
+		{name: "ToContain with an endless iter.Seq", assertion: func() error { return assertions.ToContain(seq[int](naturals), 1000) }, assertionFails: false},
+		{name: "ToContain with an unnamed iterator type", assertion: func() error { return assertions.ToContain(naturals, 3) }, assertionFails: false},
+		{name: "ToContain with an iter.Seq2, by value", assertion: func() error { return assertions.ToContain(words.All(), "b") }, assertionFails: false},
+		{name: "ToContain with a Len and All container", assertion: func() error { return assertions.ToContain(words, "a") }, assertionFails: false},
+		{name: "ToContain with an All container without the element", assertion: func() error { return assertions.ToContain(bag{items: []string{"x"}}, "y") }, assertionFails: true},
+		{name: "ToContain with a nil iterator", assertion: func() error { return assertions.ToContain(seq[int](nil), 1) }, assertionFails: true},
+		{name: "ToContain with a func that is not an iterator", assertion: func() error { return assertions.ToContain(func(int) bool { return true }, 1) }, assertionFails: true},
+		{name: "ToContainElement with an iter.Seq", assertion: func() error { return assertions.ToContainElement(values("x", "y"), "y") }, assertionFails: false},
+		{name: "ToContainElement with an iter.Seq2", assertion: func() error { return assertions.ToContainElement(words.All(), "a") }, assertionFails: false},
+		{name: "ToContainElement with an iter.Seq2 and a key", assertion: func() error { return assertions.ToContainElement(words.All(), 0) }, assertionFails: true},
+		{name: "ToContainElement with a Len and All container", assertion: func() error { return assertions.ToContainElement(words, "b") }, assertionFails: false},
+		{name: "ToContainElement with a string", assertion: func() error { return assertions.ToContainElement("ab", "a") }, assertionFails: true},
+		{name: "ToHaveLength with an iter.Seq", assertion: func() error { return assertions.ToHaveLength(values(1, 2, 3), 3) }, assertionFails: false},
+		{name: "ToHaveLength with an iter.Seq2", assertion: func() error { return assertions.ToHaveLength(words.All(), 2) }, assertionFails: false},
+		{name: "ToHaveLength with a Len method", assertion: func() error { return assertions.ToHaveLength(words, 2) }, assertionFails: false},
+		{name: "ToHaveLength with an All method", assertion: func() error { return assertions.ToHaveLength(bag{items: []string{"x"}}, 1) }, assertionFails: false},
+		{name: "ToHaveLength with a wrong length", assertion: func() error { return assertions.ToHaveLength(values(1, 2), 3) }, assertionFails: true},
+		{name: "ToHaveLengthGreaterThan with an iter.Seq", assertion: func() error { return assertions.ToHaveLengthGreaterThan(values(1, 2), 1) }, assertionFails: false},
+		{name: "ToHaveLengthLessThan with a Len method", assertion: func() error { return assertions.ToHaveLengthLessThan(words, 2) }, assertionFails: true},
+		{name: "ToHaveLength with a nil Len and All container", assertion: func() error { return assertions.ToHaveLength((*stack[string])(nil), 0) }, assertionFails: true},
+		{name: "ToContain with a nil Len and All container", assertion: func() error { return assertions.ToContain((*stack[string])(nil), "a") }, assertionFails: true},
+		{name: "ToContainElement with a nil Len and All container", assertion: func() error { return assertions.ToContainElement((*stack[string])(nil), "a") }, assertionFails: true},
+		{name: "ToHaveUniqueElements with a nil Len and All container", assertion: func() error { return assertions.ToHaveUniqueElements((*stack[string])(nil)) }, assertionFails: true},
+		{name: "ToBeSortedBy with a nil Len and All container", assertion: func() error {
+			return assertions.ToBeSortedBy((*stack[string])(nil), func(a, b string) bool { return a < b })
+		}, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := test.assertion()
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should show the elements an iterator yields", func(t *testing.T) {
+		assertionErr := assertions.ToContain(values(1, 2), 3)
+		want := "tests_test.seq[int]{1, 2} does not contain 3"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should show the keys and values a keyed iterator yields", func(t *testing.T) {
+		assertionErr := assertions.ToContainElement(words.All(), "c")
+		want := "tests_test.seq2[int,string]{0: \"b\", 1: \"a\"} does not contain element \"c\""
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+	t.Run("it should tell a nil collection apart", func(t *testing.T) {
+		assertionErr := assertions.ToContainElement((*stack[string])(nil), "a")
+		want := "(*tests_test.stack[string])(nil) is a nil collection"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -0,0 +1,235 @@
+package tests_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redjolr/goherent/expect/internal"
+	goherent "github.com/redjolr/goherent/internal"
+)
+
+type diffItem struct {
+	Name  string
+	Price int
+}
+
+type diffOrder struct {
+	ID        int
+	Items     []diffItem
+	Tags      map[string]int
+	UpdatedAt time.Time
+}
+
+func TestDiff(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	many := func(offset int) []int {
+		values := make([]int, 40)
+		for i := range values {
+			values[i] = i + offset
+		}
+		return values
+	}
+	var tests = []struct {
+		name     string
+		expected any
+		actual   any
+		want     string
+	}{
+		{
+			name:     "nested fields",
+			expected: diffOrder{ID: 1, Items: []diffItem{{"tea", 10}, {"cake", 5}}},
+			actual:   diffOrder{ID: 2, Items: []diffItem{{"tea", 12}, {"cake", 5}}},
+			want: "  .ID: expected 1, actual 2\n" +
+				"  .Items[0].Price: expected 10, actual 12\n",
+		},
+		{
+			name:     "map keys in sorted order",
+			expected: map[string]int{"c": 3, "a": 1, "b": 2},
+			actual:   map[string]int{"d": 4, "b": 20, "a": 1},
+			want: "  [\"b\"]: expected 2, actual 20\n" +
+				"  [\"c\"]: missing 3\n" +
+				"  [\"d\"]: unexpected 4\n",
+		},
+		{
+			name:     "an element inserted in a slice",
+			expected: []string{"a", "b", "c", "d"},
+			actual:   []string{"a", "b", "x", "c", "d"},
+			want: "  [0:2]: 2 equal elements\n" +
+				"  [2]: unexpected \"x\"\n" +
+				"  [3:5]: 2 equal elements\n",
+		},
+		{
+			name:     "an element deleted from a slice",
+			expected: []int{1, 2, 3},
+			actual:   []int{1, 3},
+			want: "  [0:1]: 1 equal element\n" +
+				"  [1]: missing 2\n" +
+				"  [1:2]: 1 equal element\n",
+		},
+		{
+			name:     "elements changed in a slice that also grew",
+			expected: []int{1, 2, 3},
+			actual:   []int{1, 5, 3, 4},
+			want: "  [0:1]: 1 equal element\n" +
+				"  [1]: expected 2, actual 5\n" +
+				"  [2:3]: 1 equal element\n" +
+				"  [3]: unexpected 4\n",
+		},
+		{
+			name:     "a struct changed next to an inserted one",
+			expected: []diffItem{{"tea", 1}, {"bun", 10}},
+			actual:   []diffItem{{"tea", 1}, {"cake", 5}, {"bun", 12}},
+			want: "  [0:1]: 1 equal element\n" +
+				"  [1]: unexpected tests_test.diffItem{Name:\"cake\", Price:5}\n" +
+				"  [2].Price: expected 10, actual 12\n",
+		},
+		{
+			name:     "values of any type changed in a slice that also grew",
+			expected: []any{1, "a", 3},
+			actual:   []any{1, "b", 3, 4},
+			want: "  [0:1]: 1 equal element\n" +
+				"  [1]: expected \"a\", actual \"b\"\n" +
+				"  [2:3]: 1 equal element\n" +
+				"  [3]: unexpected 4\n",
+		},
+		{
+			name:     "times compared whole",
+			expected: diffOrder{UpdatedAt: createdAt},
+			actual:   diffOrder{UpdatedAt: createdAt.Add(time.Hour)},
+			want: "  .UpdatedAt: expected time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC), " +
+				"actual time.Date(2024, time.January, 2, 4, 4, 5, 0, time.UTC)\n",
+		},
+		{
+			name:     "values of different types",
+			expected: []any{1, "a"},
+			actual:   []any{int64(1), "a"},
+			want:     "  [0]: expected int(1), actual int64(1)\n",
+		},
+		{
+			name:     "a nil and an empty slice",
+			expected: diffOrder{Items: nil},
+			actual:   diffOrder{Items: []diffItem{}},
+			want:     "  .Items: expected []tests_test.diffItem(nil), actual []tests_test.diffItem{}\n",
+		},
+		{
+			name:     "more differences than are listed",
+			expected: many(0),
+			actual:   many(100),
+			want:     "... and 10 more differences\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(goherent.COLOR_ENV, "")
+			diff := internal.Diff(tt.expected, tt.actual)
+			if !strings.HasPrefix(diff, "\n\nDiff:\n") || !strings.HasSuffix(diff, tt.want) {
+				t.Errorf("The diff does not have the expected format.\n\nShould end with:\n%s\n\nIs:\n%s", tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestDiffTruncatesOnRuneBoundaries(t *testing.T) {
+	long := strings.Repeat("é", 150)
+	diff := internal.Diff([]string{long}, []string{long + "x"})
+	if !strings.Contains(diff, "<... truncated>") {
+		t.Fatalf("expected the values to be truncated, got %q", diff)
+	}
+	if !utf8.ValidString(diff) {
+		t.Errorf("expected the truncated diff to be valid UTF-8, got %q", diff)
+	}
+}
+
+func TestDiffColors(t *testing.T) {
+	t.Setenv(goherent.COLOR_ENV, "1")
+	diff := internal.Diff([]int{1}, []int{2})
+	if want := "\033[32m1\033[0m, actual \033[31m2\033[0m"; !strings.Contains(diff, want) {
+		t.Errorf("expected the values to be coloured, got %q", diff)
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	diff = internal.Diff([]int{1}, []int{2})
+	if strings.Contains(diff, "\033[") {
+		t.Errorf("expected NO_COLOR to turn the colours off, got %q", diff)
+	}
+}
+
+func TestStringDiff(t *testing.T) {
+	var tests = []struct {
+		name     string
+		expected string
+		actual   string
+		want     string
+	}{
+		{
+			name:     "a changed character",
+			expected: `{"id":1,"name":"ada","tags":["a","b"]}`,
+			actual:   `{"id":1,"name":"ada","tags":["a","c"]}`,
+			want: "-{\"id\":1,\"name\":\"ada\",\"tags\":[\"a\",\"[-b-]\"]}\n" +
+				"+{\"id\":1,\"name\":\"ada\",\"tags\":[\"a\",\"{+c+}\"]}\n",
+		},
+		{
+			name:     "changed words",
+			expected: "the quick brown fox jumps",
+			actual:   "the slow brown dog jumps",
+			want: "-the [-quick-] brown [-fox-] jumps\n" +
+				"+the {+slow+} brown {+dog+} jumps\n",
+		},
+		{
+			name:     "changed spaces",
+			expected: "a b",
+			actual:   "a  b",
+			want: "-a b\n" +
+				"+a {+·+}b\n",
+		},
+		{
+			name:     "a tab for spaces",
+			expected: "key:\tvalue",
+			actual:   "key:  value",
+			want: "-key:[-→-]value\n" +
+				"+key:{+··+}value\n",
+		},
+		{
+			name:     "trailing spaces",
+			expected: "done",
+			actual:   "done  ",
+			want: "-done\n" +
+				"+done{+··+}\n",
+		},
+		{
+			name:     "CRLF line endings",
+			expected: "a\nb",
+			actual:   "a\r\nb",
+			want: "-a\n" +
+				"+a{+␍+}\n" +
+				" b\n",
+		},
+		{
+			name:     "an invisible character",
+			expected: "total",
+			actual:   "to\u200btal",
+			want: "-total\n" +
+				"+to{+<U+200B>+}tal\n",
+		},
+		{
+			name:     "lines with nothing in common",
+			expected: "abc",
+			actual:   "xyz",
+			want: "-abc\n" +
+				"+xyz\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(goherent.COLOR_ENV, "")
+			diff := internal.StringDiff(tt.expected, tt.actual)
+			if !strings.HasSuffix(diff, tt.want) {
+				t.Errorf("The diff does not have the expected format.\n\nShould end with:\n%s\n\nIs:\n%s", tt.want, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal"
+)
+
+func TestFormatErrorChain(t *testing.T) {
+	var tests = []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "a single error",
+			err:  io.EOF,
+			want: "error chain:\n  *errors.errorString \"EOF\"",
+		},
+		{
+			name: "wrapped errors",
+			err:  fmt.Errorf("load: %w", fmt.Errorf("read: %w", io.EOF)),
+			want: "error chain:\n" +
+				"  *fmt.wrapError \"load: read: EOF\"\n" +
+				"    *fmt.wrapError \"read: EOF\"\n" +
+				"      *errors.errorString \"EOF\"",
+		},
+		{
+			name: "joined errors",
+			err:  fmt.Errorf("close: %w", errors.Join(io.EOF, io.ErrClosedPipe)),
+			want: "error chain:\n" +
+				"  *fmt.wrapError \"close: EOF\\nio: read/write on closed pipe\"\n" +
+				"    *errors.joinError \"EOF\\nio: read/write on closed pipe\"\n" +
+				"      *errors.errorString \"EOF\"\n" +
+				"      *errors.errorString \"io: read/write on closed pipe\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("it should lay out the chain of %s", test.name), func(t *testing.T) {
+			if got := internal.FormatErrorChain(test.err); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tests_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestNumericComparison(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	hugePlusOne := new(big.Int).Add(huge, big.NewInt(1))
+	var tests = []struct {
+		name           string
+		assertion      func(a, b any) error
+		a              any
+		b              any
+		assertionFails bool
+	}{
+		{name: "an int64 greater than an int", assertion: assertions.ToBeGreaterThan, a: int64(5), b: 3, assertionFails: false},
+		{name: "a uint8 less than a float32", assertion: assertions.ToBeLessThan, a: uint8(1), b: float32(1.5), assertionFails: false},
+		{name: "an int equal to a float", assertion: assertions.ToBeGreaterThanOrEqualTo, a: 3, b: 3.0, assertionFails: false},
+		{name: "a uint64 above the int64 range", assertion: assertions.ToBeGreaterThan, a: uint64(math.MaxUint64), b: int64(math.MaxInt64), assertionFails: false},
+		{name: "a negative int and a uint", assertion: assertions.ToBeLessThan, a: -1, b: uint(0), assertionFails: false},
+		// 2^53 + 1 is not a float64: converting it would round it to 2^53.
+		{name: "an int64 that a float64 can't hold", assertion: assertions.ToBeGreaterThan, a: int64(1<<53 + 1), b: float64(1 << 53), assertionFails: false},
+		{name: "a float32 and the float64 it rounds", assertion: assertions.ToBeLessThanOrEqualTo, a: float32(0.1), b: 0.1, assertionFails: true},
+		{name: "a *big.Int greater than an int", assertion: assertions.ToBeGreaterThan, a: huge, b: math.MaxInt64, assertionFails: false},
+		{name: "two *big.Int one apart", assertion: assertions.ToBeLessThan, a: huge, b: hugePlusOne, assertionFails: false},
+		{name: "a *big.Float and a *big.Rat", assertion: assertions.ToBeLessThan, a: big.NewFloat(0.25), b: big.NewRat(1, 3), assertionFails: false},
+		{name: "a *big.Rat equal to a float", assertion: assertions.ToBeGreaterThanOrEqualTo, a: big.NewRat(1, 2), b: 0.5, assertionFails: false},
+		{name: "a duration and an int", assertion: assertions.ToBeGreaterThan, a: time.Second, b: 0, assertionFails: false},
+		{name: "infinity greater than a *big.Int", assertion: assertions.ToBeGreaterThan, a: math.Inf(1), b: huge, assertionFails: false},
+		{name: "NaN", assertion: assertions.ToBeGreaterThanOrEqualTo, a: math.NaN(), b: math.NaN(), assertionFails: true},
+		{name: "a number and a string", assertion: assertions.ToBeGreaterThan, a: 2, b: "1", assertionFails: true},
+		{name: "a nil *big.Int", assertion: assertions.ToBeGreaterThan, a: (*big.Int)(nil), b: 1, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := test.assertion(test.a, test.b)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should tell the sign of big numbers", func(t *testing.T) {
+		if err := assertions.ToBePositive(big.NewInt(1)); err != nil {
+			t.Errorf("%v", err)
+		}
+		if err := assertions.ToBeNegative(big.NewRat(-1, 3)); err != nil {
+			t.Errorf("%v", err)
+		}
+	})
+
+	t.Run("it should show the value that is not positive", func(t *testing.T) {
+		assertionErr := assertions.ToBePositive(int8(-3))
+		want := "\"-3\" is not positive"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should compare big numbers within a tolerance", func(t *testing.T) {
+		if err := assertions.ToBeCloseTo(hugePlusOne, huge, 1); err != nil {
+			t.Errorf("%v", err)
+		}
+		assertionErr := assertions.ToBeCloseTo(hugePlusOne, huge, 0.5)
+		want := "123456789012345678901234567891 is not within 0.5 of 123456789012345678901234567890 (differs by 1)"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should only find an infinity close to itself", func(t *testing.T) {
+		if err := assertions.ToBeCloseTo(math.Inf(1), math.Inf(1), 0); err != nil {
+			t.Errorf("%v", err)
+		}
+		if err := assertions.ToBeCloseTo(math.Inf(1), math.MaxFloat64, 1e300); err == nil {
+			t.Errorf("+Inf is close to the largest float64")
+		}
+		if err := assertions.ToBeCloseTo(math.NaN(), math.NaN(), math.Inf(1)); err == nil {
+			t.Errorf("NaN is close to NaN")
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func isEven(n int) bool {
+	return n%2 == 0
+}
+
+func TestToAllSatisfy(t *testing.T) {
+	var tests = []struct {
+		name           string
+		container      any
+		predicate      any
+		assertionFails bool
+	}{
+		{name: "elements that all satisfy the predicate", container: []int{2, 4}, predicate: isEven, assertionFails: false},
+		{name: "an element that does not", container: []int{2, 3}, predicate: isEven, assertionFails: true},
+		{name: "an empty collection", container: []int{}, predicate: isEven, assertionFails: false},
+		{name: "a predicate returning nil errors", container: []string{"a"}, predicate: func(s string) error { return nil }, assertionFails: false},
+		{name: "a predicate returning an error", container: []string{"a"}, predicate: func(s string) error { return errors.New("no") }, assertionFails: true},
+		{name: "elements of another type than the predicate's", container: []any{2, "4"}, predicate: isEven, assertionFails: true},
+		{name: "a predicate taking any", container: []any{2, nil}, predicate: func(v any) bool { return true }, assertionFails: false},
+		{name: "the values of a map", container: map[string]int{"a": 2}, predicate: isEven, assertionFails: false},
+		{name: "an iterator", container: values(2, 4, 6), predicate: isEven, assertionFails: false},
+		{name: "a predicate that is not a func(T) bool", container: []int{2}, predicate: func(n int) int { return n }, assertionFails: true},
+		{name: "a nil predicate", container: []int{2}, predicate: nil, assertionFails: true},
+		{name: "a value that is not a collection", container: 2, predicate: isEven, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToAllSatisfy(test.container, test.predicate)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should list the elements that do not satisfy the predicate", func(t *testing.T) {
+		assertionErr := assertions.ToAllSatisfy([]any{2, 3, "4"}, isEven)
+		want := "2 of the 3 elements do not satisfy the predicate:\n" +
+			"  [1]: 3: it does not satisfy the predicate\n" +
+			"  [2]: \"4\": it is not a int"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should show the errors of the predicate", func(t *testing.T) {
+		assertionErr := assertions.ToAllSatisfy(map[string]int{"a": 1}, func(n int) error { return fmt.Errorf("%d is odd", n) })
+		want := "1 of the 1 elements do not satisfy the predicate:\n" +
+			"  [\"a\"]: 1: 1 is odd"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should list at most 10 elements", func(t *testing.T) {
+		assertionErr := assertions.ToAllSatisfy(make([]int, 12), func(int) bool { return false })
+		if assertionErr == nil {
+			t.Fatalf("the assertion did not fail")
+		}
+		want := "  [9]: 0: it does not satisfy the predicate\n  ... and 2 more"
+		if got := assertionErr.Error(); len(got) < len(want) || got[len(got)-len(want):] != want {
+			t.Errorf("got:\n%v\nwant it to end with:\n%s", assertionErr, want)
+		}
+	})
+}
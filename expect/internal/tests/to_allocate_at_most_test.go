@@ -0,0 +1,70 @@
+package tests_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+// sink keeps the allocations of the functions below from being optimised away.
+var sink []byte
+
+func TestToAllocateAtMost(t *testing.T) {
+	var tests = []struct {
+		name           string
+		fn             any
+		n              int
+		assertionFails bool
+	}{
+		{name: "a function that does not allocate", fn: func() {}, n: 0, assertionFails: false},
+		{name: "a function that allocates once, at most once", fn: func() { sink = make([]byte, 64) }, n: 1, assertionFails: false},
+		{name: "a function that allocates once, at most never", fn: func() { sink = make([]byte, 64) }, n: 0, assertionFails: true},
+		{name: "a function that allocates twice, at most once", fn: func() {
+			sink = make([]byte, 64)
+			sink = append(make([]byte, 0, 1), sink...)
+		}, n: 1, assertionFails: true},
+		{name: "a function of another type", fn: func() int { return 1 }, n: 0, assertionFails: true},
+		{name: "a non-function value", fn: 42, n: 0, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToAllocateAtMost(test.fn, test.n)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should tell how many times the function allocated", func(t *testing.T) {
+		assertionErr := assertions.ToAllocateAtMost(func() { sink = make([]byte, 64) }, 0)
+		want := "the function made 1 allocations per run, more than 0 (average of 100 runs)"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+	t.Run("it should fail the assertion, instead of panicking, in a parallel test", func(t *testing.T) {
+		t.Parallel()
+		assertionErr := assertions.ToAllocateAtMost(func() {}, 0)
+		if assertionErr == nil || !strings.Contains(assertionErr.Error(), "cannot be measured in a parallel test") {
+			t.Errorf("got:\n%v\nwant an error about parallel tests", assertionErr)
+		}
+	})
+
+	t.Run("it should pass on a panic of the function", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v, want boom", r)
+			}
+		}()
+		assertions.ToAllocateAtMost(func() { panic("boom") }, 0)
+	})
+}
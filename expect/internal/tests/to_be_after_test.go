@@ -0,0 +1,47 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToBeAfter(t *testing.T) {
+	var tests = []struct {
+		value          any
+		t              time.Time
+		assertionFails bool
+	}{
+		{value: noon.Add(time.Nanosecond), t: noon, assertionFails: false},
+		{value: &noon, t: noon.Add(-time.Hour), assertionFails: false},
+		{value: noon, t: noon, assertionFails: true},
+		{value: noon.Add(-time.Hour), t: noon, assertionFails: true},
+		{value: 12, t: noon, assertionFails: true},
+		{value: nil, t: noon, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail, if %#v is not after %v", test.value, test.t)
+		} else {
+			testName = fmt.Sprintf("it should not fail, if %#v is after %v", test.value, test.t)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeAfter(test.value, test.t)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should tell how much earlier the time is", func(t *testing.T) {
+		assertionErr := assertions.ToBeAfter(noon.Add(-time.Second), noon)
+		want := "2024-05-06T11:59:59Z is not after 2024-05-06T12:00:00Z: it is 1s before"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+var noon = time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+
+func TestToBeBefore(t *testing.T) {
+	var tests = []struct {
+		value          any
+		t              time.Time
+		assertionFails bool
+	}{
+		{value: noon.Add(-time.Nanosecond), t: noon, assertionFails: false},
+		{value: &noon, t: noon.Add(time.Hour), assertionFails: false},
+		{value: noon, t: noon, assertionFails: true},
+		// The same instant in another location is not before.
+		{value: noon.In(time.FixedZone("CEST", 2*60*60)), t: noon, assertionFails: true},
+		{value: noon.Add(time.Hour), t: noon, assertionFails: true},
+		{value: "2024-05-06", t: noon, assertionFails: true},
+		{value: (*time.Time)(nil), t: noon, assertionFails: true},
+		{value: nil, t: noon, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail, if %#v is not before %v", test.value, test.t)
+		} else {
+			testName = fmt.Sprintf("it should not fail, if %#v is before %v", test.value, test.t)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeBefore(test.value, test.t)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should tell how much later the time is", func(t *testing.T) {
+		assertionErr := assertions.ToBeBefore(noon.Add(90*time.Minute), noon)
+		want := "2024-05-06T13:30:00Z is not before 2024-05-06T12:00:00Z: it is 1h30m0s after"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should leave out the monotonic clock reading", func(t *testing.T) {
+		now := time.Now()
+		assertionErr := assertions.ToBeBefore(now, now)
+		want := fmt.Sprintf("%s is not before %s: it is the same instant", now.Format(time.RFC3339Nano), now.Format(time.RFC3339Nano))
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
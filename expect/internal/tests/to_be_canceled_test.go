@@ -0,0 +1,37 @@
+package tests_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToBeCanceled(t *testing.T) {
+	var tests = []struct {
+		name           string
+		ctx            any
+		assertionFails bool
+	}{
+		{name: "a canceled context", ctx: canceledContext(), assertionFails: false},
+		{name: "a context past its deadline", ctx: expiredContext(), assertionFails: true},
+		{name: "a background context", ctx: context.Background(), assertionFails: true},
+		{name: "a non-context value", ctx: "ctx", assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeCanceled(test.ctx)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
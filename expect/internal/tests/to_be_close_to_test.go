@@ -2,7 +2,9 @@ package tests_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/redjolr/goherent/expect/internal/assertions"
 )
@@ -30,6 +32,20 @@ func TestToBeCloseTo(t *testing.T) {
 		{value: 1.0, target: "y", tolerance: 1.0, assertionFails: true},
 		{value: 1.0, target: 1.0, tolerance: "z", assertionFails: true},
 		{value: nil, target: 1.0, tolerance: 1.0, assertionFails: true},
+		// Durations are compared within a duration.
+		{value: 1100 * time.Millisecond, target: time.Second, tolerance: 100 * time.Millisecond, assertionFails: false},
+		{value: 800 * time.Millisecond, target: time.Second, tolerance: 100 * time.Millisecond, assertionFails: true},
+		// Durations are numbers of nanoseconds, as for the ordering matchers.
+		{value: time.Second, target: 1e9, tolerance: time.Millisecond, assertionFails: false},
+		{value: time.Second, target: time.Second, tolerance: 1e6, assertionFails: false},
+		{value: time.Second, target: 2e9, tolerance: 1e6, assertionFails: true},
+		// The difference of durations near the int64 limits doesn't overflow.
+		{value: time.Duration(math.MaxInt64), target: time.Duration(-1), tolerance: time.Second, assertionFails: true},
+		{value: time.Duration(math.MinInt64), target: time.Duration(math.MaxInt64), tolerance: time.Duration(math.MaxInt64), assertionFails: true},
+		// Times are compared within a duration.
+		{value: noon.Add(time.Second), target: noon, tolerance: time.Second, assertionFails: false},
+		{value: noon.Add(time.Minute), target: noon, tolerance: time.Second, assertionFails: true},
+		{value: noon, target: noon, tolerance: 1.0, assertionFails: true},
 	}
 
 	for _, test := range tests {
@@ -46,4 +62,19 @@ func TestToBeCloseTo(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("it should show durations as durations", func(t *testing.T) {
+		assertionErr := assertions.ToBeCloseTo(1500*time.Millisecond, time.Second, 100*time.Millisecond)
+		want := "1.5s is not within 100ms of 1s (differs by 500ms)"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+	t.Run("it should show a difference too large for a duration in nanoseconds", func(t *testing.T) {
+		assertionErr := assertions.ToBeCloseTo(time.Duration(math.MaxInt64), time.Duration(-1), time.Second)
+		want := "2562047h47m16.854775807s is not within 1s of -1ns (differs by 9223372036854775808ns)"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
 }
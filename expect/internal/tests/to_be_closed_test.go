@@ -0,0 +1,53 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToBeClosed(t *testing.T) {
+	closedWithBufferedValue := bufferedChannel(1)
+	close(closedWithBufferedValue)
+	var tests = []struct {
+		name           string
+		ch             any
+		assertionFails bool
+	}{
+		{name: "a closed channel", ch: closedChannel(), assertionFails: false},
+		{name: "an open empty channel", ch: bufferedChannel(), assertionFails: true},
+		{name: "an open channel with a buffered value", ch: bufferedChannel(1), assertionFails: true},
+		{name: "a closed channel with a buffered value", ch: closedWithBufferedValue, assertionFails: true},
+		{name: "a nil channel", ch: (chan int)(nil), assertionFails: true},
+		{name: "a non-channel value", ch: 42, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeClosed(test.ch)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should keep the buffered values of the channel", func(t *testing.T) {
+		ch := bufferedChannel(1, 2)
+		close(ch)
+		assertionErr := assertions.ToBeClosed(ch)
+		want := "chan int (2 of 3 buffered) still holds buffered values, so it is open, or closed but not drained"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+		if len(ch) != 2 || <-ch != 1 {
+			t.Errorf("the buffered values were consumed")
+		}
+	})
+}
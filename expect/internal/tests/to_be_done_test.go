@@ -0,0 +1,52 @@
+package tests_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func canceledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+func expiredContext() context.Context {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	// The deadline has passed, so canceling leaves the context's error as is.
+	defer cancel()
+	return ctx
+}
+
+func TestToBeDone(t *testing.T) {
+	var tests = []struct {
+		name           string
+		ctx            any
+		assertionFails bool
+	}{
+		{name: "a canceled context", ctx: canceledContext(), assertionFails: false},
+		{name: "a context past its deadline", ctx: expiredContext(), assertionFails: false},
+		{name: "a background context", ctx: context.Background(), assertionFails: true},
+		{name: "a non-context value", ctx: 42, assertionFails: true},
+		{name: "a nil value", ctx: nil, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeDone(test.ctx)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToBeErrorAs(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "config.yml", Err: fs.ErrNotExist}
+	var tests = []struct {
+		name           string
+		err            any
+		target         any
+		assertionFails bool
+	}{
+		{name: "an error of the target type", err: pathErr, target: new(*fs.PathError), assertionFails: false},
+		{name: "an error wrapping one of the target type", err: fmt.Errorf("load: %w", pathErr), target: new(*fs.PathError), assertionFails: false},
+		{name: "joined errors including one of the target type", err: errors.Join(io.EOF, pathErr), target: new(*fs.PathError), assertionFails: false},
+		{name: "a target pointing to an interface", err: pathErr, target: new(interface{ Timeout() bool }), assertionFails: false},
+		{name: "no error of the target type", err: io.EOF, target: new(*fs.PathError), assertionFails: true},
+		{name: "a nil target", err: pathErr, target: nil, assertionFails: true},
+		{name: "a target that is not a pointer", err: pathErr, target: fs.PathError{}, assertionFails: true},
+		{name: "a target pointing to a non-error type", err: pathErr, target: new(string), assertionFails: true},
+		{name: "a non-error value", err: "EOF", target: new(*fs.PathError), assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeErrorAs(test.err, test.target)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
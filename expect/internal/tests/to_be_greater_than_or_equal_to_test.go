@@ -14,12 +14,14 @@ func TestToBeGreaterThanOrEqualTo(t *testing.T) {
 		checkIfGreaterOrEqualAgainst any
 		assertionFails               bool
 	}{
+		// Numbers of different types are compared as numbers
+		{isGreaterOrEqualCandidate: 2, checkIfGreaterOrEqualAgainst: 1.2, assertionFails: false},
+		{isGreaterOrEqualCandidate: int(3), checkIfGreaterOrEqualAgainst: int64(1), assertionFails: false},
+
 		// Assertion should fail for different types
-		{isGreaterOrEqualCandidate: 2, checkIfGreaterOrEqualAgainst: 1.2, assertionFails: true},
 		{isGreaterOrEqualCandidate: 2, checkIfGreaterOrEqualAgainst: false, assertionFails: true},
 		{isGreaterOrEqualCandidate: 3, checkIfGreaterOrEqualAgainst: "", assertionFails: true},
 		{isGreaterOrEqualCandidate: 3, checkIfGreaterOrEqualAgainst: struct{ a float32 }{a: 1.3}, assertionFails: true},
-		{isGreaterOrEqualCandidate: int(3), checkIfGreaterOrEqualAgainst: int64(1), assertionFails: true},
 
 		// Invalid comparisons (booleans)
 		{isGreaterOrEqualCandidate: true, checkIfGreaterOrEqualAgainst: false, assertionFails: true},
@@ -79,9 +81,12 @@ func TestToBeGreaterThanOrEqualTo(t *testing.T) {
 		{isGreaterOrEqualCandidate: uintptr(100), checkIfGreaterOrEqualAgainst: nil, assertionFails: true}, // uintptr vs nil
 		{isGreaterOrEqualCandidate: nil, checkIfGreaterOrEqualAgainst: uintptr(100), assertionFails: true}, // nil vs uintptr
 
-		// Invalid comparisons (uintptr with incompatible types)
-		{isGreaterOrEqualCandidate: uintptr(100), checkIfGreaterOrEqualAgainst: 100, assertionFails: true},        // uintptr vs int
-		{isGreaterOrEqualCandidate: uintptr(100), checkIfGreaterOrEqualAgainst: 100.0, assertionFails: true},      // uintptr vs float
+		// Comparisons of uintptr with other numeric types, by value
+		{isGreaterOrEqualCandidate: uintptr(100), checkIfGreaterOrEqualAgainst: 100, assertionFails: false},   // uintptr vs int
+		{isGreaterOrEqualCandidate: uintptr(100), checkIfGreaterOrEqualAgainst: 100.0, assertionFails: false}, // uintptr vs float
+		{isGreaterOrEqualCandidate: uintptr(100), checkIfGreaterOrEqualAgainst: 50, assertionFails: false},    // uintptr vs int
+
+		// Invalid comparisons (uintptr with non-numeric types)
 		{isGreaterOrEqualCandidate: uintptr(100), checkIfGreaterOrEqualAgainst: "100", assertionFails: true},      // uintptr vs string
 		{isGreaterOrEqualCandidate: uintptr(100), checkIfGreaterOrEqualAgainst: struct{}{}, assertionFails: true}, // uintptr vs struct
 
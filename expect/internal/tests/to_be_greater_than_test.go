@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/redjolr/goherent/expect/internal/assertions"
 )
@@ -14,12 +15,14 @@ func TestToBeGreaterThan(t *testing.T) {
 		checkIfGreaterAgainst any
 		assertionFails        bool
 	}{
+		// Numbers of different types are compared as numbers
+		{isGreaterCandidate: 2, checkIfGreaterAgainst: 1.2, assertionFails: false},
+		{isGreaterCandidate: int(3), checkIfGreaterAgainst: int64(1), assertionFails: false},
+
 		// Assertion should fail for different types
-		{isGreaterCandidate: 2, checkIfGreaterAgainst: 1.2, assertionFails: true},
 		{isGreaterCandidate: 2, checkIfGreaterAgainst: false, assertionFails: true},
 		{isGreaterCandidate: 3, checkIfGreaterAgainst: "", assertionFails: true},
 		{isGreaterCandidate: 3, checkIfGreaterAgainst: struct{ a float32 }{a: 1.3}, assertionFails: true},
-		{isGreaterCandidate: int(3), checkIfGreaterAgainst: int64(1), assertionFails: true},
 
 		// Invalid comparisons (booleans)
 		{isGreaterCandidate: true, checkIfGreaterAgainst: false, assertionFails: true},
@@ -79,9 +82,12 @@ func TestToBeGreaterThan(t *testing.T) {
 		{isGreaterCandidate: uintptr(100), checkIfGreaterAgainst: nil, assertionFails: true}, // uintptr vs nil
 		{isGreaterCandidate: nil, checkIfGreaterAgainst: uintptr(100), assertionFails: true}, // nil vs uintptr
 
-		// Invalid comparisons (uintptr with incompatible types)
-		{isGreaterCandidate: uintptr(100), checkIfGreaterAgainst: 100, assertionFails: true},        // uintptr vs int
-		{isGreaterCandidate: uintptr(100), checkIfGreaterAgainst: 100.0, assertionFails: true},      // uintptr vs float
+		// Comparisons of uintptr with other numeric types, by value
+		{isGreaterCandidate: uintptr(100), checkIfGreaterAgainst: 100, assertionFails: true},   // uintptr vs int
+		{isGreaterCandidate: uintptr(100), checkIfGreaterAgainst: 100.0, assertionFails: true}, // uintptr vs float
+		{isGreaterCandidate: uintptr(100), checkIfGreaterAgainst: 50, assertionFails: false},   // uintptr vs int
+
+		// Invalid comparisons (uintptr with non-numeric types)
 		{isGreaterCandidate: uintptr(100), checkIfGreaterAgainst: "100", assertionFails: true},      // uintptr vs string
 		{isGreaterCandidate: uintptr(100), checkIfGreaterAgainst: struct{}{}, assertionFails: true}, // uintptr vs struct
 
@@ -117,4 +123,21 @@ func TestToBeGreaterThan(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("it should show times without their monotonic clock reading", func(t *testing.T) {
+		now := time.Now()
+		assertionErr := assertions.ToBeGreaterThan(now, now.Add(time.Hour))
+		want := fmt.Sprintf("\"%s\" is not greater than \"%s\"", now.Format(time.RFC3339Nano), now.Add(time.Hour).Format(time.RFC3339Nano))
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should compare durations", func(t *testing.T) {
+		assertionErr := assertions.ToBeGreaterThan(time.Second, 1500*time.Millisecond)
+		want := "\"1s\" is not greater than \"1.5s\""
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
 }
@@ -14,12 +14,14 @@ func TestToBeLessThanOrEqualTo(t *testing.T) {
 		checkIfLessOrEqualAgainst any
 		assertionFails            bool
 	}{
-		// Assertion should fail for different types
+		// Numbers of different types are compared as numbers
 		{isLessOrEqualCandidate: 2, checkIfLessOrEqualAgainst: 1.2, assertionFails: true},
+		{isLessOrEqualCandidate: int(3), checkIfLessOrEqualAgainst: int64(1), assertionFails: true},
+
+		// Assertion should fail for different types
 		{isLessOrEqualCandidate: 2, checkIfLessOrEqualAgainst: false, assertionFails: true},
 		{isLessOrEqualCandidate: 3, checkIfLessOrEqualAgainst: "", assertionFails: true},
 		{isLessOrEqualCandidate: 3, checkIfLessOrEqualAgainst: struct{ a float32 }{a: 1.3}, assertionFails: true},
-		{isLessOrEqualCandidate: int(3), checkIfLessOrEqualAgainst: int64(1), assertionFails: true},
 
 		// Invalid comparisons (booleans)
 		{isLessOrEqualCandidate: true, checkIfLessOrEqualAgainst: false, assertionFails: true},
@@ -79,9 +81,12 @@ func TestToBeLessThanOrEqualTo(t *testing.T) {
 		{isLessOrEqualCandidate: uintptr(100), checkIfLessOrEqualAgainst: nil, assertionFails: true}, // uintptr vs nil
 		{isLessOrEqualCandidate: nil, checkIfLessOrEqualAgainst: uintptr(100), assertionFails: true}, // nil vs uintptr
 
-		// Invalid comparisons (uintptr with incompatible types)
-		{isLessOrEqualCandidate: uintptr(100), checkIfLessOrEqualAgainst: 100, assertionFails: true},        // uintptr vs int
-		{isLessOrEqualCandidate: uintptr(100), checkIfLessOrEqualAgainst: 100.0, assertionFails: true},      // uintptr vs float
+		// Comparisons of uintptr with other numeric types, by value
+		{isLessOrEqualCandidate: uintptr(100), checkIfLessOrEqualAgainst: 100, assertionFails: false},   // uintptr vs int
+		{isLessOrEqualCandidate: uintptr(100), checkIfLessOrEqualAgainst: 100.0, assertionFails: false}, // uintptr vs float
+		{isLessOrEqualCandidate: uintptr(100), checkIfLessOrEqualAgainst: 200, assertionFails: false},   // uintptr vs int
+
+		// Invalid comparisons (uintptr with non-numeric types)
 		{isLessOrEqualCandidate: uintptr(100), checkIfLessOrEqualAgainst: "100", assertionFails: true},      // uintptr vs string
 		{isLessOrEqualCandidate: uintptr(100), checkIfLessOrEqualAgainst: struct{}{}, assertionFails: true}, // uintptr vs struct
 
@@ -14,12 +14,14 @@ func TestToBeLessThan(t *testing.T) {
 		checkIfLessAgainst any
 		assertionFails     bool
 	}{
-		// Assertion should fail for different types
+		// Numbers of different types are compared as numbers
 		{isLessCandidate: 2, checkIfLessAgainst: 1.2, assertionFails: true},
+		{isLessCandidate: int(3), checkIfLessAgainst: int64(1), assertionFails: true},
+
+		// Assertion should fail for different types
 		{isLessCandidate: 2, checkIfLessAgainst: false, assertionFails: true},
 		{isLessCandidate: 3, checkIfLessAgainst: "", assertionFails: true},
 		{isLessCandidate: 3, checkIfLessAgainst: struct{ a float32 }{a: 1.3}, assertionFails: true},
-		{isLessCandidate: int(3), checkIfLessAgainst: int64(1), assertionFails: true},
 
 		// Invalid comparisons (booleans)
 		{isLessCandidate: true, checkIfLessAgainst: false, assertionFails: true},
@@ -79,9 +81,12 @@ func TestToBeLessThan(t *testing.T) {
 		{isLessCandidate: uintptr(100), checkIfLessAgainst: nil, assertionFails: true}, // uintptr vs nil
 		{isLessCandidate: nil, checkIfLessAgainst: uintptr(100), assertionFails: true}, // nil vs uintptr
 
-		// Invalid comparisons (uintptr with incompatible types)
-		{isLessCandidate: uintptr(100), checkIfLessAgainst: 100, assertionFails: true},        // uintptr vs int
-		{isLessCandidate: uintptr(100), checkIfLessAgainst: 100.0, assertionFails: true},      // uintptr vs float
+		// Comparisons of uintptr with other numeric types, by value
+		{isLessCandidate: uintptr(100), checkIfLessAgainst: 100, assertionFails: true},   // uintptr vs int
+		{isLessCandidate: uintptr(100), checkIfLessAgainst: 100.0, assertionFails: true}, // uintptr vs float
+		{isLessCandidate: uintptr(100), checkIfLessAgainst: 200, assertionFails: false},  // uintptr vs int
+
+		// Invalid comparisons (uintptr with non-numeric types)
 		{isLessCandidate: uintptr(100), checkIfLessAgainst: "100", assertionFails: true},      // uintptr vs string
 		{isLessCandidate: uintptr(100), checkIfLessAgainst: struct{}{}, assertionFails: true}, // uintptr vs struct
 
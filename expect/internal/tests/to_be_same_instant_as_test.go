@@ -0,0 +1,50 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToBeSameInstantAs(t *testing.T) {
+	now := time.Now()
+	var tests = []struct {
+		value          any
+		t              time.Time
+		assertionFails bool
+	}{
+		{value: noon, t: noon, assertionFails: false},
+		{value: noon.In(time.FixedZone("CEST", 2*60*60)), t: noon, assertionFails: false},
+		// Round(0) strips the monotonic clock reading.
+		{value: now, t: now.Round(0), assertionFails: false},
+		{value: noon.Add(time.Nanosecond), t: noon, assertionFails: true},
+		{value: time.Date(2024, 5, 6, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)), t: noon, assertionFails: true},
+		{value: "noon", t: noon, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail, if %#v is not the same instant as %v", test.value, test.t)
+		} else {
+			testName = fmt.Sprintf("it should not fail, if %#v is the same instant as %v", test.value, test.t)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeSameInstantAs(test.value, test.t)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should show the times with their offsets", func(t *testing.T) {
+		cest := time.FixedZone("CEST", 2*60*60)
+		assertionErr := assertions.ToBeSameInstantAs(time.Date(2024, 5, 6, 12, 0, 0, 0, cest), noon)
+		want := "2024-05-06T12:00:00+02:00 is not the same instant as 2024-05-06T12:00:00Z (differs by 2h0m0s)"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToBeSortedBy(t *testing.T) {
+	ascending := func(a, b int) bool { return a < b }
+	var tests = []struct {
+		name           string
+		container      any
+		less           any
+		assertionFails bool
+	}{
+		{name: "sorted elements", container: []int{1, 2, 3}, less: ascending, assertionFails: false},
+		{name: "sorted elements with equal neighbours", container: []int{1, 2, 2, 3}, less: ascending, assertionFails: false},
+		{name: "unsorted elements", container: []int{1, 3, 2}, less: ascending, assertionFails: true},
+		{name: "an empty slice", container: []int{}, less: ascending, assertionFails: false},
+		{name: "an array", container: [3]string{"c", "b", "a"}, less: func(a, b string) bool { return a > b }, assertionFails: false},
+		{name: "an iterator", container: values(1, 2, 3), less: ascending, assertionFails: false},
+		{name: "elements of another type than the function's", container: []any{1, "2"}, less: ascending, assertionFails: true},
+		{name: "a map", container: map[int]int{1: 1}, less: ascending, assertionFails: true},
+		{name: "a less function that is not a func(a, b T) bool", container: []int{1}, less: func(a int) bool { return true }, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeSortedBy(test.container, test.less)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should point at the first element out of order", func(t *testing.T) {
+		assertionErr := assertions.ToBeSortedBy([]int{1, 5, 3, 2}, ascending)
+		want := "[]int{1, 5, 3, 2} is not sorted: [2]: 3 goes before [1]: 5"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
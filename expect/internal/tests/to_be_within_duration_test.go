@@ -0,0 +1,50 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToBeWithinDuration(t *testing.T) {
+	var tests = []struct {
+		value          any
+		t              time.Time
+		delta          time.Duration
+		assertionFails bool
+	}{
+		{value: noon.Add(500 * time.Millisecond), t: noon, delta: time.Second, assertionFails: false},
+		{value: noon.Add(-500 * time.Millisecond), t: noon, delta: time.Second, assertionFails: false},
+		// The delta is inclusive.
+		{value: noon.Add(time.Second), t: noon, delta: time.Second, assertionFails: false},
+		{value: noon, t: noon, delta: 0, assertionFails: false},
+		{value: noon.Add(time.Second + 1), t: noon, delta: time.Second, assertionFails: true},
+		{value: noon.Add(-2 * time.Second), t: noon, delta: time.Second, assertionFails: true},
+		{value: time.Second, t: noon, delta: time.Second, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail, if %#v is not within %v of %v", test.value, test.delta, test.t)
+		} else {
+			testName = fmt.Sprintf("it should not fail, if %#v is within %v of %v", test.value, test.delta, test.t)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToBeWithinDuration(test.value, test.t, test.delta)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should tell how far apart the times are", func(t *testing.T) {
+		assertionErr := assertions.ToBeWithinDuration(noon.Add(-1500*time.Millisecond), noon, time.Second)
+		want := "2024-05-06T11:59:58.5Z is not within 1s of 2024-05-06T12:00:00Z (differs by 1.5s)"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
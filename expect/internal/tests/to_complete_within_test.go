@@ -0,0 +1,67 @@
+package tests_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToCompleteWithin(t *testing.T) {
+	var tests = []struct {
+		name           string
+		fn             any
+		timeout        time.Duration
+		assertionFails bool
+	}{
+		{name: "a function that returns at once", fn: func() {}, timeout: time.Second, assertionFails: false},
+		{name: "a function that overruns", fn: func() { time.Sleep(50 * time.Millisecond) }, timeout: 10 * time.Millisecond, assertionFails: true},
+		{name: "a function that never returns", fn: func() { select {} }, timeout: 10 * time.Millisecond, assertionFails: true},
+		{name: "a function that returns when its context is done", fn: func(ctx context.Context) {
+			<-ctx.Done()
+			time.Sleep(time.Millisecond)
+		}, timeout: 10 * time.Millisecond, assertionFails: true},
+		{name: "a function that returns before its deadline", fn: func(ctx context.Context) {
+			if _, ok := ctx.Deadline(); !ok {
+				panic("the context has no deadline")
+			}
+		}, timeout: time.Second, assertionFails: false},
+		{name: "a function that panics", fn: func() { panic("boom") }, timeout: time.Second, assertionFails: true},
+		{name: "a function that takes other arguments", fn: func(int) {}, timeout: time.Second, assertionFails: true},
+		{name: "a non-function value", fn: 42, timeout: time.Second, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToCompleteWithin(test.fn, test.timeout)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should tell how long an overrunning function took", func(t *testing.T) {
+		assertionErr := assertions.ToCompleteWithin(func() { time.Sleep(30 * time.Millisecond) }, 20*time.Millisecond)
+		want := regexp.MustCompile(`^the function did not complete within 20ms: it took 3\d(\.\d+)?ms$`)
+		if assertionErr == nil || !want.MatchString(assertionErr.Error()) {
+			t.Errorf("got:\n%v\nwant it to match:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should tell that a function is still running", func(t *testing.T) {
+		assertionErr := assertions.ToCompleteWithin(func() { time.Sleep(time.Second) }, 10*time.Millisecond)
+		want := regexp.MustCompile(`^the function did not complete within 10ms: it was still running after 2\d(\.\d+)?ms$`)
+		if assertionErr == nil || !want.MatchString(assertionErr.Error()) {
+			t.Errorf("got:\n%v\nwant it to match:\n%s", assertionErr, want)
+		}
+	})
+}
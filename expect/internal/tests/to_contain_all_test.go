@@ -0,0 +1,48 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToContainAll(t *testing.T) {
+	var tests = []struct {
+		name           string
+		assertion      func() error
+		assertionFails bool
+	}{
+		{name: "all the elements in another order", assertion: func() error { return assertions.ToContainAll([]int{3, 1, 2}, 1, 3) }, assertionFails: false},
+		{name: "no elements", assertion: func() error { return assertions.ToContainAll([]int{1}) }, assertionFails: false},
+		{name: "a repeated element found as many times", assertion: func() error { return assertions.ToContainAll([]int{1, 2, 1}, 1, 1) }, assertionFails: false},
+		{name: "a repeated element found fewer times", assertion: func() error { return assertions.ToContainAll([]int{1, 2}, 1, 1) }, assertionFails: true},
+		{name: "a missing element", assertion: func() error { return assertions.ToContainAll([]string{"a", "b"}, "a", "c") }, assertionFails: true},
+		{name: "the values of a map", assertion: func() error { return assertions.ToContainAll(map[string]int{"a": 1, "b": 2}, 2, 1) }, assertionFails: false},
+		{name: "an iterator", assertion: func() error { return assertions.ToContainAll(values(1, 2, 3), 3, 2) }, assertionFails: false},
+		{name: "a string", assertion: func() error { return assertions.ToContainAll("abc", "a") }, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := test.assertion()
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should list the missing elements", func(t *testing.T) {
+		assertionErr := assertions.ToContainAll([]int{1, 2}, 2, 3, 4)
+		want := "[]int{1, 2} does not contain all the elements:\nmissing: 3, 4"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package tests_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal"
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+// containing is a placeholder matching the strings containing substring.
+func containing(substring string) any {
+	return internal.NewAsymmetricMatcher(fmt.Sprintf("containing(%q)", substring), func(actual any) bool {
+		s, ok := actual.(string)
+		return ok && strings.Contains(s, substring)
+	})
+}
+
+func TestToContainExactlyInAnyOrder(t *testing.T) {
+	var tests = []struct {
+		name           string
+		assertion      func() error
+		assertionFails bool
+	}{
+		{name: "the same elements in another order", assertion: func() error { return assertions.ToContainExactlyInAnyOrder([]int{3, 1, 2}, 1, 2, 3) }, assertionFails: false},
+		{name: "repeated elements as many times", assertion: func() error { return assertions.ToContainExactlyInAnyOrder([]int{1, 2, 1}, 1, 1, 2) }, assertionFails: false},
+		{name: "an empty collection and no elements", assertion: func() error { return assertions.ToContainExactlyInAnyOrder([]int{}) }, assertionFails: false},
+		{name: "an element repeated fewer times", assertion: func() error { return assertions.ToContainExactlyInAnyOrder([]int{1, 2}, 1, 1, 2) }, assertionFails: true},
+		{name: "an unexpected element", assertion: func() error { return assertions.ToContainExactlyInAnyOrder([]int{1, 2, 3}, 1, 2) }, assertionFails: true},
+		{name: "placeholders that match several elements", assertion: func() error {
+			return assertions.ToContainExactlyInAnyOrder([]any{"a", 1}, containing("a"), "a")
+		}, assertionFails: true},
+		{name: "the values of a map", assertion: func() error { return assertions.ToContainExactlyInAnyOrder(map[string]int{"a": 1, "b": 2}, 2, 1) }, assertionFails: false},
+		{name: "an iterator", assertion: func() error { return assertions.ToContainExactlyInAnyOrder(values("x", "y"), "y", "x") }, assertionFails: false},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := test.assertion()
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should show the missing and the unexpected elements apart", func(t *testing.T) {
+		assertionErr := assertions.ToContainExactlyInAnyOrder([]int{1, 5, 2, 6}, 1, 2, 3)
+		want := "[]int{1, 5, 2, 6} does not contain exactly the elements, in any order:\nmissing   : 3\nunexpected: [1]: 5, [3]: 6"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should pair placeholders with the elements left for them", func(t *testing.T) {
+		// Pairing the placeholder with "a", the first element it matches, would
+		// leave "ab" unpaired.
+		assertionErr := assertions.ToContainExactlyInAnyOrder([]any{"a", "ab"}, containing("a"), "a")
+		if assertionErr != nil {
+			t.Errorf("%v", assertionErr)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+type document struct {
+	Name string   `json:"name" yaml:"name" xml:"name"`
+	Tags []string `json:"tags" yaml:"tags" xml:"tag"`
+}
+
+func TestToEqualDocument(t *testing.T) {
+	var tests = []struct {
+		name           string
+		assertion      func(value, expected any) error
+		value          any
+		expected       any
+		assertionFails bool
+	}{
+		{name: "JSON with the keys in another order", assertion: assertions.ToEqualJSON, value: `{"b": 2, "a": 1}`, expected: `{"a":1,"b":2}`, assertionFails: false},
+		{name: "JSON with other whitespace", assertion: assertions.ToEqualJSON, value: "{\n\t\"a\": [1, 2]\n}\n", expected: `{"a":[1,2]}`, assertionFails: false},
+		{name: "JSON numbers written differently", assertion: assertions.ToEqualJSON, value: `[1, 1.50, 1e2]`, expected: `[1.0, 1.5, 100]`, assertionFails: false},
+		{name: "JSON given as []byte", assertion: assertions.ToEqualJSON, value: []byte(`{"a": null}`), expected: `{"a":null}`, assertionFails: false},
+		{name: "JSON marshalled from a struct", assertion: assertions.ToEqualJSON, value: `{"tags":["math"],"name":"ada"}`, expected: document{Name: "ada", Tags: []string{"math"}}, assertionFails: false},
+		{name: "JSON marshalled from a map", assertion: assertions.ToEqualJSON, value: map[string]any{"a": 1}, expected: `{"a": 1}`, assertionFails: false},
+		{name: "JSON with a different value", assertion: assertions.ToEqualJSON, value: `{"a": 1}`, expected: `{"a": 2}`, assertionFails: true},
+		{name: "JSON with large integers that differ beyond a float64", assertion: assertions.ToEqualJSON, value: `12345678901234567890`, expected: `12345678901234567891`, assertionFails: true},
+		{name: "JSON with a number and a string", assertion: assertions.ToEqualJSON, value: `{"a": 1}`, expected: `{"a": "1"}`, assertionFails: true},
+		{name: "JSON arrays in another order", assertion: assertions.ToEqualJSON, value: `[1, 2]`, expected: `[2, 1]`, assertionFails: true},
+		{name: "JSON with an extra key", assertion: assertions.ToEqualJSON, value: `{"a": 1, "b": 2}`, expected: `{"a": 1}`, assertionFails: true},
+		{name: "invalid actual JSON", assertion: assertions.ToEqualJSON, value: `{"a": }`, expected: `{}`, assertionFails: true},
+		{name: "invalid expected JSON", assertion: assertions.ToEqualJSON, value: `{}`, expected: `{} {}`, assertionFails: true},
+		{name: "a value that can't be marshalled to JSON", assertion: assertions.ToEqualJSON, value: func() {}, expected: `{}`, assertionFails: true},
+		{name: "YAML with the keys in another order", assertion: assertions.ToEqualYAML, value: "b: 2\na: 1\n", expected: "a: 1\nb: 2", assertionFails: false},
+		{name: "YAML in flow and block style", assertion: assertions.ToEqualYAML, value: "tags: [math, code]", expected: "tags:\n  - math\n  - code\n", assertionFails: false},
+		{name: "YAML marshalled from a struct", assertion: assertions.ToEqualYAML, value: "name: ada\ntags: [math]", expected: document{Name: "ada", Tags: []string{"math"}}, assertionFails: false},
+		{name: "YAML with a different value", assertion: assertions.ToEqualYAML, value: "a: 1", expected: "a: 2", assertionFails: true},
+		{name: "invalid YAML", assertion: assertions.ToEqualYAML, value: "a: [1", expected: "a: [1]", assertionFails: true},
+		{name: "XML with the attributes in another order", assertion: assertions.ToEqualXML, value: `<a y="2" x="1"/>`, expected: `<a x="1" y="2"></a>`, assertionFails: false},
+		{name: "XML with other whitespace and comments", assertion: assertions.ToEqualXML, value: "<a>\n  <!-- c -->\n  <b> text </b>\n</a>", expected: `<a><b>text</b></a>`, assertionFails: false},
+		{name: "XML marshalled from a struct", assertion: assertions.ToEqualXML, value: `<document><name>ada</name><tag>math</tag></document>`, expected: document{Name: "ada", Tags: []string{"math"}}, assertionFails: false},
+		{name: "XML children in another order", assertion: assertions.ToEqualXML, value: `<a><b/><c/></a>`, expected: `<a><c/><b/></a>`, assertionFails: true},
+		{name: "XML with a different attribute", assertion: assertions.ToEqualXML, value: `<a x="1"/>`, expected: `<a x="2"/>`, assertionFails: true},
+		{name: "invalid XML", assertion: assertions.ToEqualXML, value: `<a><b></a>`, expected: `<a/>`, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := test.assertion(test.value, test.expected)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should show the differences by path", func(t *testing.T) {
+		assertionErr := assertions.ToEqualJSON(
+			`{"user": {"name": "ada", "tags": ["math", "code"]}, "id": 1}`,
+			`{"id": 1, "user": {"name": "grace", "tags": ["math"]}}`,
+		)
+		want := "JSON documents are not equal:\n" +
+			"Diff:\n" +
+			"  [\"user\"][\"name\"]: expected \"grace\", actual \"ada\"\n" +
+			"  [\"user\"][\"tags\"][0:1]: 1 equal element\n" +
+			"  [\"user\"][\"tags\"][1]: unexpected \"code\"\n"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should show the differences of XML elements by path", func(t *testing.T) {
+		assertionErr := assertions.ToEqualXML(`<a x="1"><b>t</b></a>`, `<a x="2"><b>u</b></a>`)
+		want := "XML documents are not equal:\n" +
+			"Diff:\n" +
+			"  .Attributes[\"x\"]: expected \"2\", actual \"1\"\n" +
+			"  .Children[0].Text: expected \"u\", actual \"t\"\n"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should show differing scalar documents", func(t *testing.T) {
+		assertionErr := assertions.ToEqualJSON(`1.50`, `"1.5"`)
+		want := "JSON documents are not equal:\n" +
+			"expected: \"1.5\"\n" +
+			"actual  : 1.5"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should tell which document is invalid", func(t *testing.T) {
+		assertionErr := assertions.ToEqualJSON(`{}`, `{} {}`)
+		want := "the expected document is invalid: \"{} {}\" is not valid JSON: unexpected data after the top-level value"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -288,8 +288,8 @@ func TestToEqualStrings(t *testing.T) {
 			"--- Expected\n" +
 			"+++ Actual\n" +
 			"@@ -1 +1 @@\n" +
-			"-Hello\n" +
-			"+Hallo\n"
+			"-H[-e-]llo\n" +
+			"+H{+a+}llo\n"
 
 		if err == nil {
 			t.Error("Hello does not equal Hallo, but ToEqual() assertion says they do.")
@@ -315,8 +315,8 @@ func TestToEqualStrings(t *testing.T) {
 			"--- Expected\n" +
 			"+++ Actual\n" +
 			"@@ -1,2 +1,2 @@\n" +
-			"-Hello\n" +
-			"+Hallo\n" +
+			"-H[-e-]llo\n" +
+			"+H{+a+}llo\n" +
 			" World\n"
 
 		if err == nil {
@@ -343,9 +343,9 @@ func TestToEqualStrings(t *testing.T) {
 			"--- Expected\n" +
 			"+++ Actual\n" +
 			"@@ -1,2 +1,2 @@\n" +
-			"-Hello\n" +
+			"-H[-e-]llo\n" +
 			"-World\n" +
-			"+Hallo\n" +
+			"+H{+a+}llo\n" +
 			"+Welt\n"
 
 		if err == nil {
@@ -372,8 +372,8 @@ func TestToEqualStrings(t *testing.T) {
 			"--- Expected\n" +
 			"+++ Actual\n" +
 			"@@ -1,2 +1,2 @@\n" +
-			"-Hello\n" +
-			"+Hallo\n" +
+			"-H[-e-]llo\n" +
+			"+H{+a+}llo\n" +
 			" There\n"
 
 		if err == nil {
@@ -400,8 +400,8 @@ func TestToEqualStrings(t *testing.T) {
 			"--- Expected\n" +
 			"+++ Actual\n" +
 			"@@ -1,3 +1,3 @@\n" +
-			"-Hello\n" +
-			"+Hallo\n" +
+			"-H[-e-]llo\n" +
+			"+H{+a+}llo\n" +
 			" There\n" +
 			"-World\n" +
 			"+Welt\n"
@@ -430,10 +430,10 @@ func TestToEqualStrings(t *testing.T) {
 			"--- Expected\n" +
 			"+++ Actual\n" +
 			"@@ -1,3 +1,3 @@\n" +
-			"-Hello\n" +
+			"-H[-e-]llo\n" +
 			"-There\n" +
 			"-World\n" +
-			"+Hallo\n" +
+			"+H{+a+}llo\n" +
 			"+Dort\n" +
 			"+Welt\n"
 
@@ -839,13 +839,7 @@ func TestToEqualSlices(t *testing.T) {
 			"expected: []int{7, 9}\n" +
 			"actual  : []int{7, 12}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -2,3 +2,3 @@\n" +
-			"  (int) 7,\n" +
-			"- (int) 9\n" +
-			"+ (int) 12\n" +
-			" }\n"
+			"  [1]: expected 9, actual 12\n"
 		err := assertions.ToEqual(slice1, slice2)
 		if err == nil {
 			t.Error("Slices should not be equal, but ToEqual() assertion says they are.")
@@ -936,15 +930,8 @@ func TestToEqualSlices(t *testing.T) {
 			"expected: []float64{7.502, 9.3111}\n" +
 			"actual  : []float64{7.333, 9.22}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,4 +1,4 @@\n" +
-			" ([]float64) (len=2) {\n" +
-			"- (float64) 7.502,\n" +
-			"- (float64) 9.3111\n" +
-			"+ (float64) 7.333,\n" +
-			"+ (float64) 9.22\n" +
-			" }\n"
+			"  [0]: expected 7.502, actual 7.333\n" +
+			"  [1]: expected 9.3111, actual 9.22\n"
 		err := assertions.ToEqual(slice1, slice2)
 		if err == nil {
 			t.Error("Slices should not be equal, but ToEqual() assertion says they are.")
@@ -1036,13 +1023,7 @@ func TestToEqualSlices(t *testing.T) {
 			"expected: []bool{true, false}\n" +
 			"actual  : []bool{false, false}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" ([]bool) (len=2) {\n" +
-			"- (bool) true,\n" +
-			"+ (bool) false,\n" +
-			"  (bool) false\n"
+			"  [0]: expected true, actual false\n"
 		if err == nil {
 			t.Error("Slices should not be equal, but ToEqual() assertion says they are.")
 		}
@@ -1132,13 +1113,7 @@ func TestToEqualSlices(t *testing.T) {
 			"expected: []string{\"Hello\", \"World\"}\n" +
 			"actual  : []string{\"Pershendetje\", \"World\"}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" ([]string) (len=2) {\n" +
-			"- (string) (len=5) \"Hello\",\n" +
-			"+ (string) (len=12) \"Pershendetje\",\n" +
-			"  (string) (len=5) \"World\"\n"
+			"  [0]: expected \"Hello\", actual \"Pershendetje\"\n"
 		err := assertions.ToEqual(slice1, slice2)
 		if err == nil {
 			t.Error("Slices should not be equal, but ToEqual() assertion says they are.")
@@ -1251,13 +1226,7 @@ func TestToEqualStructs(t *testing.T) {
 			"expected: tests_test.S{field:1}\n" +
 			"actual  : tests_test.S{field:2}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (tests_test.S) {\n" +
-			"- field: (int) 1\n" +
-			"+ field: (int) 2\n" +
-			" }\n"
+			"  .field: expected 1, actual 2\n"
 		err := assertions.ToEqual(s1, s2)
 		if err == nil {
 			t.Error("Structs are not equal, but ToEqual() assertion says they are.")
@@ -1323,13 +1292,7 @@ func TestToEqualStructs(t *testing.T) {
 			"expected: tests_test.S{field:2.3}\n" +
 			"actual  : tests_test.S{field:2.7}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (tests_test.S) {\n" +
-			"- field: (float64) 2.3\n" +
-			"+ field: (float64) 2.7\n" +
-			" }\n"
+			"  .field: expected 2.3, actual 2.7\n"
 		err := assertions.ToEqual(s1, s2)
 		if err == nil {
 			t.Error("Structs are not equal, but ToEqual() assertion says they are.")
@@ -1371,13 +1334,7 @@ func TestToEqualStructs(t *testing.T) {
 			"expected: tests_test.S{field:true}\n" +
 			"actual  : tests_test.S{field:false}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (tests_test.S) {\n" +
-			"- field: (bool) true\n" +
-			"+ field: (bool) false\n" +
-			" }\n"
+			"  .field: expected true, actual false\n"
 		err := assertions.ToEqual(s1, s2)
 		if err == nil {
 			t.Error("Structs are not equal, but ToEqual() assertion says they are.")
@@ -1443,13 +1400,7 @@ func TestToEqualStructs(t *testing.T) {
 			"expected: tests_test.S{field:\"Hello\"}\n" +
 			"actual  : tests_test.S{field:\"Hi\"}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (tests_test.S) {\n" +
-			"- field: (string) (len=5) \"Hello\"\n" +
-			"+ field: (string) (len=2) \"Hi\"\n" +
-			" }\n"
+			"  .field: expected \"Hello\", actual \"Hi\"\n"
 		err := assertions.ToEqual(s1, s2)
 		if err == nil {
 			t.Error("Structs are not equal, but ToEqual() assertion says they are.")
@@ -1515,15 +1466,8 @@ func TestToEqualStructs(t *testing.T) {
 			"expected: tests_test.S{field:[2]int{10, 20}}\n" +
 			"actual  : tests_test.S{field:[2]int{13, 17}}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -2,4 +2,4 @@\n" +
-			"  field: ([2]int) (len=2) {\n" +
-			"-  (int) 10,\n" +
-			"-  (int) 20\n" +
-			"+  (int) 13,\n" +
-			"+  (int) 17\n" +
-			"  }\n"
+			"  .field[0]: expected 10, actual 13\n" +
+			"  .field[1]: expected 20, actual 17\n"
 		err := assertions.ToEqual(s1, s2)
 
 		if err == nil {
@@ -1590,15 +1534,8 @@ func TestToEqualStructs(t *testing.T) {
 			"expected: tests_test.S{field:[]int{10, 20}}\n" +
 			"actual  : tests_test.S{field:[]int{13, 17}}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -2,4 +2,4 @@\n" +
-			"  field: ([]int) (len=2) {\n" +
-			"-  (int) 10,\n" +
-			"-  (int) 20\n" +
-			"+  (int) 13,\n" +
-			"+  (int) 17\n" +
-			"  }\n"
+			"  .field[0]: expected 10, actual 13\n" +
+			"  .field[1]: expected 20, actual 17\n"
 		err := assertions.ToEqual(s1, s2)
 
 		if err == nil {
@@ -1670,13 +1607,7 @@ func TestToEqualStructs(t *testing.T) {
 			"expected: tests_test.S{field:2}\n" +
 			"actual  : tests_test.S{field:16}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (tests_test.S) {\n" +
-			"- field: (int) 2\n" +
-			"+ field: (int) 16\n" +
-			" }\n"
+			"  .field: expected 2, actual 16\n"
 		err := assertions.ToEqual(s1, s2)
 		if err == nil {
 			t.Error("Structs are not equal, but ToEqual() assertion says they are.")
@@ -1808,13 +1739,7 @@ func TestToEqualMaps(t *testing.T) {
 			"expected: map[string]int{\"k1\":2}\n" +
 			"actual  : map[string]int{\"k1\":3}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (map[string]int) (len=1) {\n" +
-			"- (string) (len=2) \"k1\": (int) 2\n" +
-			"+ (string) (len=2) \"k1\": (int) 3\n" +
-			" }\n"
+			"  [\"k1\"]: expected 2, actual 3\n"
 		if err == nil {
 			t.Error("Maps should not be equal, but ToEqual() assertion says they are.")
 		}
@@ -1834,13 +1759,8 @@ func TestToEqualMaps(t *testing.T) {
 			"expected: map[string]int{\"k1\":2}\n" +
 			"actual  : map[string]int{\"k2\":2}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (map[string]int) (len=1) {\n" +
-			"- (string) (len=2) \"k1\": (int) 2\n" +
-			"+ (string) (len=2) \"k2\": (int) 2\n" +
-			" }\n"
+			"  [\"k1\"]: missing 2\n" +
+			"  [\"k2\"]: unexpected 2\n"
 		if err == nil {
 			t.Error("Maps should not be equal, but ToEqual() assertion says they are.")
 		}
@@ -1860,13 +1780,7 @@ func TestToEqualMaps(t *testing.T) {
 			"expected: map[int]int{1:7}\n" +
 			"actual  : map[int]int{1:2}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (map[int]int) (len=1) {\n" +
-			"- (int) 1: (int) 7\n" +
-			"+ (int) 1: (int) 2\n" +
-			" }\n"
+			"  [1]: expected 7, actual 2\n"
 		if err == nil {
 			t.Error("Maps should not be equal, but ToEqual() assertion says they are.")
 		}
@@ -1886,13 +1800,7 @@ func TestToEqualMaps(t *testing.T) {
 			"expected: map[bool]string{true:\"true\"}\n" +
 			"actual  : map[bool]string{true:\"false\"}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -1,3 +1,3 @@\n" +
-			" (map[bool]string) (len=1) {\n" +
-			"- (bool) true: (string) (len=4) \"true\"\n" +
-			"+ (bool) true: (string) (len=5) \"false\"\n" +
-			" }\n"
+			"  [true]: expected \"true\", actual \"false\"\n"
 		if err == nil {
 			t.Error("Maps should be equal, but ToEqual() assertion says they are.")
 		}
@@ -1913,13 +1821,7 @@ func TestToEqualMaps(t *testing.T) {
 			"expected: map[string][]int{\"k\":[]int{2}}\n" +
 			"actual  : map[string][]int{\"k\":[]int{3}}\n\n" +
 			"Diff:\n" +
-			"--- Expected\n" +
-			"+++ Actual\n" +
-			"@@ -2,3 +2,3 @@\n" +
-			"  (string) (len=1) \"k\": ([]int) (len=1) {\n" +
-			"-  (int) 2\n" +
-			"+  (int) 3\n" +
-			"  }\n"
+			"  [\"k\"][0]: expected 2, actual 3\n"
 		if err == nil {
 			t.Error("Maps should not be equal, but ToEqual() assertion says they are.")
 		}
@@ -0,0 +1,35 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToHaveBeenCalled(t *testing.T) {
+	var tests = []struct {
+		calls          [][]any
+		assertionFails bool
+	}{
+		{calls: [][]any{{"ada"}}, assertionFails: false},
+		{calls: [][]any{{}}, assertionFails: false},
+		{calls: [][]any{}, assertionFails: true},
+		{calls: nil, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for the calls %#v", test.calls)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for the calls %#v", test.calls)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToHaveBeenCalled(test.calls)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToHaveBeenCalledTimes(t *testing.T) {
+	var tests = []struct {
+		calls          [][]any
+		times          int
+		assertionFails bool
+	}{
+		{calls: [][]any{{"ada"}, {"grace"}}, times: 2, assertionFails: false},
+		{calls: nil, times: 0, assertionFails: false},
+		{calls: [][]any{{"ada"}, {"grace"}}, times: 1, assertionFails: true},
+		{calls: [][]any{{"ada"}}, times: 2, assertionFails: true},
+		{calls: nil, times: 1, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, if %#v are not %d calls", test.calls, test.times)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, if %#v are %d calls", test.calls, test.times)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToHaveBeenCalledTimes(test.calls, test.times)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
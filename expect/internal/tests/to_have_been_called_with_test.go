@@ -0,0 +1,46 @@
+package tests_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToHaveBeenCalledWith(t *testing.T) {
+	calls := [][]any{{"ada", 36}, {"grace", 40, []byte("x")}}
+	var tests = []struct {
+		expected       []any
+		assertionFails bool
+	}{
+		{expected: []any{"ada", 36}, assertionFails: false},
+		{expected: []any{"grace", 40, []byte("x")}, assertionFails: false},
+		{expected: []any{"ada", 40}, assertionFails: true},
+		{expected: []any{"ada"}, assertionFails: true},
+		{expected: []any{"ada", int64(36)}, assertionFails: true},
+		{expected: []any{}, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, if no call was made with %#v", test.expected)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, if a call was made with %#v", test.expected)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToHaveBeenCalledWith(calls, test.expected)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should diff the expected arguments against the closest call", func(t *testing.T) {
+		assertionErr := assertions.ToHaveBeenCalledWith(calls, []any{"grace", 41, []byte("x")})
+		if assertionErr == nil || !strings.Contains(assertionErr.Error(), "closest call: 2") {
+			t.Errorf("%v", assertionErr)
+		}
+	})
+}
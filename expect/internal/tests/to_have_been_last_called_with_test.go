@@ -0,0 +1,35 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToHaveBeenLastCalledWith(t *testing.T) {
+	var tests = []struct {
+		calls          [][]any
+		expected       []any
+		assertionFails bool
+	}{
+		{calls: [][]any{{"ada"}, {"grace"}}, expected: []any{"grace"}, assertionFails: false},
+		{calls: [][]any{{"ada"}, {"grace"}}, expected: []any{"ada"}, assertionFails: true},
+		{calls: nil, expected: []any{}, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, if the last of %#v is not a call with %#v", test.calls, test.expected)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, if the last of %#v is a call with %#v", test.calls, test.expected)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToHaveBeenLastCalledWith(test.calls, test.expected)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToHaveErrorMessage(t *testing.T) {
+	err := errors.New("open config.yml: permission denied")
+	var tests = []struct {
+		name             string
+		err              any
+		substringOrRegex any
+		assertionFails   bool
+	}{
+		{name: "a contained substring", err: err, substringOrRegex: "permission denied", assertionFails: false},
+		{name: "a substring that is not contained", err: err, substringOrRegex: "not found", assertionFails: true},
+		{name: "a matching regex", err: err, substringOrRegex: regexp.MustCompile(`^open \S+: permission denied$`), assertionFails: false},
+		{name: "a regex that does not match", err: err, substringOrRegex: regexp.MustCompile(`^read`), assertionFails: true},
+		{name: "neither a substring nor a regex", err: err, substringOrRegex: 42, assertionFails: true},
+		{name: "a nil error", err: nil, substringOrRegex: "", assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToHaveErrorMessage(test.err, test.substringOrRegex)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToHaveSomeSatisfying(t *testing.T) {
+	var tests = []struct {
+		name           string
+		container      any
+		predicate      any
+		assertionFails bool
+	}{
+		{name: "an element that satisfies the predicate", container: []int{1, 2}, predicate: isEven, assertionFails: false},
+		{name: "no element that does", container: []int{1, 3}, predicate: isEven, assertionFails: true},
+		{name: "an empty collection", container: []int{}, predicate: isEven, assertionFails: true},
+		{name: "elements of other types and one that satisfies it", container: []any{"1", 2}, predicate: isEven, assertionFails: false},
+		{name: "a predicate returning nil for one element", container: []int{1, 2}, predicate: func(n int) error {
+			if n == 2 {
+				return nil
+			}
+			return fmt.Errorf("%d is not 2", n)
+		}, assertionFails: false},
+		{name: "an endless iterator", container: seq[int](naturals), predicate: func(n int) bool { return n > 100 }, assertionFails: false},
+		{name: "a predicate that is not a func(T) bool", container: []int{2}, predicate: "even", assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToHaveSomeSatisfying(test.container, test.predicate)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should show why no element satisfies the predicate", func(t *testing.T) {
+		assertionErr := assertions.ToHaveSomeSatisfying([]int{1, 3}, func(n int) error { return fmt.Errorf("%d is odd", n) })
+		want := "none of the 2 elements satisfies the predicate:\n" +
+			"  [0]: 1: 1 is odd\n" +
+			"  [1]: 3: 3 is odd"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
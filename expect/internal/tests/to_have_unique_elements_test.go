@@ -0,0 +1,50 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToHaveUniqueElements(t *testing.T) {
+	var tests = []struct {
+		container      any
+		assertionFails bool
+	}{
+		{container: []int{1, 2, 3}, assertionFails: false},
+		{container: []int{1, 2, 1}, assertionFails: true},
+		{container: []int{}, assertionFails: false},
+		{container: []any{1, int64(1)}, assertionFails: false},
+		{container: [][]int{{1}, {1}}, assertionFails: true},
+		{container: map[string]int{"a": 1, "b": 1}, assertionFails: true},
+		{container: values("a", "b"), assertionFails: false},
+		{container: "aa", assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, if %#v does not have unique elements", test.container)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, if %#v has unique elements", test.container)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToHaveUniqueElements(test.container)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should point at the duplicates", func(t *testing.T) {
+		assertionErr := assertions.ToHaveUniqueElements([]string{"a", "b", "a", "b", "a"})
+		want := "[]string{\"a\", \"b\", \"a\", \"b\", \"a\"} does not have unique elements:\n" +
+			"  [2]: \"a\" is a duplicate of [0]: \"a\"\n" +
+			"  [4]: \"a\" is a duplicate of [0]: \"a\"\n" +
+			"  [3]: \"b\" is a duplicate of [1]: \"b\""
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToMatchError(t *testing.T) {
+	var tests = []struct {
+		name           string
+		err            any
+		target         any
+		assertionFails bool
+	}{
+		{name: "the target itself", err: io.EOF, target: io.EOF, assertionFails: false},
+		{name: "an error wrapping the target", err: fmt.Errorf("read: %w", io.EOF), target: io.EOF, assertionFails: false},
+		{name: "joined errors including the target", err: errors.Join(fs.ErrExist, fmt.Errorf("read: %w", io.EOF)), target: io.EOF, assertionFails: false},
+		{name: "an error formatting the target without wrapping it", err: fmt.Errorf("read: %v", io.EOF), target: io.EOF, assertionFails: true},
+		{name: "a different error", err: fs.ErrExist, target: io.EOF, assertionFails: true},
+		{name: "a nil error", err: nil, target: io.EOF, assertionFails: true},
+		{name: "a non-error value", err: "EOF", target: io.EOF, assertionFails: true},
+		{name: "a non-error target", err: io.EOF, target: "EOF", assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToMatchError(test.err, test.target)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package tests_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+type address struct {
+	City    string
+	Country string
+}
+
+type user struct {
+	Name      string
+	Age       int
+	Address   *address
+	Tags      []string
+	Friends   []user
+	CreatedAt time.Time
+	Err       error
+	private   string
+}
+
+type admin struct {
+	*user
+	Level int
+}
+
+func TestToMatchObject(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	ada := user{
+		Name:      "ada",
+		Age:       36,
+		Address:   &address{City: "London", Country: "UK"},
+		Tags:      []string{"math", "code"},
+		Friends:   []user{{Name: "charles", Age: 45}},
+		CreatedAt: createdAt,
+		private:   "x",
+	}
+	var tests = []struct {
+		name           string
+		object         any
+		subset         any
+		assertionFails bool
+	}{
+		{name: "a map subset of fields", object: ada, subset: map[string]any{"Name": "ada", "Age": 36}, assertionFails: false},
+		{name: "a nested map subset", object: ada, subset: map[string]any{"Address": map[string]any{"City": "London"}}, assertionFails: false},
+		{name: "a struct subset ignoring its zero fields", object: ada, subset: user{Name: "ada", Address: &address{Country: "UK"}}, assertionFails: false},
+		{name: "a pointer to the object", object: &ada, subset: map[string]any{"Name": "ada"}, assertionFails: false},
+		{name: "slices of partial objects", object: ada, subset: map[string]any{"Friends": []map[string]any{{"Name": "charles"}}}, assertionFails: false},
+		{name: "an equal time", object: ada, subset: map[string]any{"CreatedAt": createdAt}, assertionFails: false},
+		{name: "a nil error", object: ada, subset: map[string]any{"Err": nil}, assertionFails: false},
+		{name: "a subset of a map", object: map[string]int{"a": 1, "b": 2}, subset: map[string]int{"a": 1}, assertionFails: false},
+		{name: "a different value", object: ada, subset: map[string]any{"Name": "grace"}, assertionFails: true},
+		{name: "a different nested value", object: ada, subset: map[string]any{"Address": map[string]any{"City": "Paris"}}, assertionFails: true},
+		{name: "a missing field", object: ada, subset: map[string]any{"Email": "ada@example.com"}, assertionFails: true},
+		{name: "an unexported field", object: ada, subset: map[string]any{"private": "x"}, assertionFails: true},
+		{name: "a slice with fewer elements", object: ada, subset: map[string]any{"Tags": []string{"math"}}, assertionFails: true},
+		{name: "a different time", object: ada, subset: map[string]any{"CreatedAt": createdAt.Add(time.Hour)}, assertionFails: true},
+		{name: "a missing map key", object: map[string]int{"a": 1}, subset: map[string]int{"b": 2}, assertionFails: true},
+		{name: "a subset that is not an object", object: ada, subset: "ada", assertionFails: true},
+		{name: "an object that is not a struct or a map", object: "ada", subset: map[string]any{}, assertionFails: true},
+		{name: "a nil object", object: nil, subset: map[string]any{}, assertionFails: true},
+		{name: "a field promoted from an embedded pointer", object: admin{user: &ada, Level: 1}, subset: map[string]any{"Name": "ada"}, assertionFails: false},
+		{name: "a field behind a nil embedded pointer", object: admin{Level: 1}, subset: map[string]any{"Name": "ada"}, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToMatchObject(test.object, test.subset)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should only show the mismatched paths", func(t *testing.T) {
+		assertionErr := assertions.ToMatchObject(ada, map[string]any{
+			"Name":    "ada",
+			"Address": map[string]any{"City": "Paris", "Country": "UK"},
+			"Friends": []map[string]any{{"Age": 46}},
+			"Email":   "ada@example.com",
+		})
+		want := "the object does not match at 3 paths:\n" +
+			"  .Address.City\n    expected: \"Paris\"\n    actual  : \"London\"\n" +
+			"  .Email\n    expected: \"ada@example.com\"\n    actual  : <missing>\n" +
+			"  .Friends[0].Age\n    expected: 46\n    actual  : 45"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+		if assertionErr != nil && strings.Contains(assertionErr.Error(), ".Name") {
+			t.Errorf("the matching path .Name is shown")
+		}
+	})
+	t.Run("it should show a field behind a nil embedded pointer as missing", func(t *testing.T) {
+		assertionErr := assertions.ToMatchObject(admin{Level: 1}, map[string]any{"Name": "ada", "Level": 1})
+		want := "the object does not match at 1 path:\n" +
+			"  .Name\n    expected: \"ada\"\n    actual  : <missing: behind a nil embedded pointer>"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -40,3 +40,17 @@ func TestToMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestToMatchShowsInvisibleCharacters(t *testing.T) {
+	err := assertions.ToMatch("status: ok \r\nnext:\tstep\u200b", `^status: ok$`)
+	expectedErrorMsg := `"status: ok \r\nnext:\tstep\u200b" does not match pattern "^status: ok$"` + "\n" +
+		"string:\n" +
+		"  status: ok·␍\n" +
+		"  next:→step<U+200B>"
+	if err == nil {
+		t.Fatal("expected the assertion to fail, but it passed")
+	}
+	if err.Error() != expectedErrorMsg {
+		t.Errorf("The error message does not have the expected format.\n\nShould be:\n%s\n\nIs:\n%s", expectedErrorMsg, err.Error())
+	}
+}
@@ -0,0 +1,54 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToPanicMatching(t *testing.T) {
+	var tests = []struct {
+		name           string
+		fn             any
+		pattern        string
+		assertionFails bool
+	}{
+		{name: "a panic with a matching string", fn: func() { panic("index 5 out of range") }, pattern: `out of range$`, assertionFails: false},
+		{name: "a panic with a string that does not match", fn: func() { panic("nil map") }, pattern: `out of range`, assertionFails: true},
+		{name: "a panic with a matching error", fn: func() { panic(errors.New("connection refused")) }, pattern: `^connection`, assertionFails: false},
+		{name: "a runtime error", fn: func() {
+			var items []int
+			_ = items[5]
+		}, pattern: `index out of range \[5\]`, assertionFails: false},
+		{name: "a panic with a Stringer", fn: func() { panic(time.Second) }, pattern: `^1s$`, assertionFails: false},
+		{name: "a panic with an int", fn: func() { panic(42) }, pattern: `^42$`, assertionFails: false},
+		{name: "a function that does not panic", fn: func() {}, pattern: `.*`, assertionFails: true},
+		{name: "an invalid pattern", fn: func() { panic("boom") }, pattern: `(`, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToPanicMatching(test.fn, test.pattern)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should show the message that does not match", func(t *testing.T) {
+		assertionErr := assertions.ToPanicMatching(func() { panic(errors.New("nil map")) }, `out of range`)
+		want := "the function panicked with *errors.errorString(\"nil map\"), whose message \"nil map\" does not match \"out of range\""
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+var errBoom = errors.New("boom")
+
+func TestToPanicWith(t *testing.T) {
+	var tests = []struct {
+		name           string
+		fn             any
+		value          any
+		assertionFails bool
+	}{
+		{name: "a panic with the string", fn: func() { panic("boom") }, value: "boom", assertionFails: false},
+		{name: "a panic with another string", fn: func() { panic("bang") }, value: "boom", assertionFails: true},
+		{name: "a panic with an equal struct", fn: func() { panic(address{City: "Paris"}) }, value: address{City: "Paris"}, assertionFails: false},
+		{name: "a panic with the error", fn: func() { panic(errBoom) }, value: errBoom, assertionFails: false},
+		{name: "a panic with an error wrapping the error", fn: func() { panic(fmt.Errorf("run: %w", errBoom)) }, value: errBoom, assertionFails: false},
+		{name: "a panic with another error", fn: func() { panic(errors.New("bang")) }, value: errBoom, assertionFails: true},
+		{name: "a panic with an int and an int64", fn: func() { panic(1) }, value: int64(1), assertionFails: true},
+		{name: "a function that does not panic", fn: func() {}, value: "boom", assertionFails: true},
+		{name: "a function that takes arguments", fn: func(int) { panic("boom") }, value: "boom", assertionFails: true},
+		{name: "a non-function value", fn: "boom", value: "boom", assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToPanicWith(test.fn, test.value)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should show the value the function panicked with", func(t *testing.T) {
+		assertionErr := assertions.ToPanicWith(func() { panic(errors.New("bang")) }, "boom")
+		want := "the function panicked with *errors.errorString(\"bang\"), not with \"boom\""
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package tests_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToReceiveNothing(t *testing.T) {
+	var tests = []struct {
+		name           string
+		ch             any
+		assertionFails bool
+	}{
+		{name: "an empty channel", ch: bufferedChannel(), assertionFails: false},
+		{name: "a closed channel", ch: closedChannel(), assertionFails: false},
+		{name: "a nil channel", ch: (chan int)(nil), assertionFails: false},
+		{name: "a channel with a buffered value", ch: bufferedChannel(1), assertionFails: true},
+		{name: "a non-channel value", ch: "chan", assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToReceiveNothing(test.ch, 10*time.Millisecond)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func bufferedChannel(values ...int) chan int {
+	ch := make(chan int, len(values)+1)
+	for _, value := range values {
+		ch <- value
+	}
+	return ch
+}
+
+func closedChannel() chan int {
+	ch := make(chan int)
+	close(ch)
+	return ch
+}
+
+func TestToReceive(t *testing.T) {
+	acceptAll := func(received any) error { return nil }
+	rejectAll := func(received any) error { return errors.New("rejected") }
+	var tests = []struct {
+		name           string
+		ch             any
+		onReceive      func(received any) error
+		assertionFails bool
+	}{
+		{name: "a channel with a buffered value", ch: bufferedChannel(1), onReceive: acceptAll, assertionFails: false},
+		{name: "a channel with a buffered value rejected by onReceive", ch: bufferedChannel(1), onReceive: rejectAll, assertionFails: true},
+		{name: "an empty channel", ch: bufferedChannel(), onReceive: acceptAll, assertionFails: true},
+		{name: "a closed channel", ch: closedChannel(), onReceive: acceptAll, assertionFails: true},
+		{name: "a receive-only channel with a buffered value", ch: (<-chan int)(bufferedChannel(1)), onReceive: acceptAll, assertionFails: false},
+		{name: "a send-only channel", ch: (chan<- int)(bufferedChannel(1)), onReceive: acceptAll, assertionFails: true},
+		{name: "a nil channel", ch: (chan int)(nil), onReceive: acceptAll, assertionFails: true},
+		{name: "a non-channel value", ch: 42, onReceive: acceptAll, assertionFails: true},
+		{name: "a nil value", ch: nil, onReceive: acceptAll, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToReceive(test.ch, 10*time.Millisecond, test.onReceive)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package tests_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToReturnError(t *testing.T) {
+	atoi := func() (int, error) { return strconv.Atoi("x") }
+	var tests = []struct {
+		name           string
+		fn             any
+		expected       []any
+		assertionFails bool
+	}{
+		{name: "a function returning an error", fn: atoi, assertionFails: false},
+		{name: "a function returning a nil error", fn: func() (int, error) { return 1, nil }, assertionFails: true},
+		{name: "a function returning only an error", fn: func() error { return errBoom }, assertionFails: false},
+		{name: "an error matching the target", fn: atoi, expected: []any{strconv.ErrSyntax}, assertionFails: false},
+		{name: "an error not matching the target", fn: atoi, expected: []any{strconv.ErrRange}, assertionFails: true},
+		{name: "an error message containing the substring", fn: atoi, expected: []any{"invalid syntax"}, assertionFails: false},
+		{name: "an error message not containing the substring", fn: atoi, expected: []any{"out of range"}, assertionFails: true},
+		{name: "an error message matching the regex", fn: atoi, expected: []any{regexp.MustCompile(`^strconv\.Atoi`)}, assertionFails: false},
+		{name: "an expectation that is not an error, substring or regex", fn: atoi, expected: []any{42}, assertionFails: true},
+		{name: "several expectations", fn: atoi, expected: []any{strconv.ErrSyntax, "syntax"}, assertionFails: true},
+		{name: "a function whose last result is not an error", fn: func() (error, int) { return errBoom, 1 }, assertionFails: true},
+		{name: "a function that panics", fn: func() error { panic("boom") }, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToReturnError(test.fn, test.expected...)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should show what the function returned instead of an error", func(t *testing.T) {
+		assertionErr := assertions.ToReturnError(func() (int, error) { return 7, nil })
+		want := "the function should have returned an error, but it returned (7, nil)"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package tests_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToReturn(t *testing.T) {
+	var tests = []struct {
+		name           string
+		fn             any
+		values         []any
+		assertionFails bool
+	}{
+		{name: "a function returning the value", fn: func() int { return 42 }, values: []any{42}, assertionFails: false},
+		{name: "a function returning another value", fn: func() int { return 41 }, values: []any{42}, assertionFails: true},
+		{name: "a function returning the values", fn: func() (string, bool) { return "a", true }, values: []any{"a", true}, assertionFails: false},
+		{name: "a function returning a value and a nil error, given the value", fn: func() (int, error) { return strconv.Atoi("42") }, values: []any{42}, assertionFails: false},
+		{name: "a function returning a value and an error, given the value", fn: func() (int, error) { return strconv.Atoi("x") }, values: []any{0}, assertionFails: true},
+		{name: "a function returning a value and a nil error, given both", fn: func() (int, error) { return 1, nil }, values: []any{1, nil}, assertionFails: false},
+		{name: "a function returning an error, given it", fn: func() (int, error) { return 0, errBoom }, values: []any{0, errBoom}, assertionFails: false},
+		{name: "a function returning nothing, given nothing", fn: func() {}, values: nil, assertionFails: false},
+		{name: "a function returning an error only, given nothing", fn: func() error { return nil }, values: nil, assertionFails: false},
+		{name: "too many values", fn: func() int { return 1 }, values: []any{1, 2}, assertionFails: true},
+		{name: "too few values", fn: func() (int, bool) { return 1, true }, values: []any{1}, assertionFails: true},
+		{name: "a function that panics", fn: func() int { panic("boom") }, values: []any{1}, assertionFails: true},
+		{name: "a function that takes arguments", fn: func(n int) int { return n }, values: []any{1}, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToReturn(test.fn, test.values...)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should point at the results that differ", func(t *testing.T) {
+		assertionErr := assertions.ToReturn(func() (string, int, bool) { return "a", 1, false }, "a", 2, true)
+		want := "the function returned (\"a\", 1, false), not (\"a\", 2, true):\n" +
+			"  result 1: expected 2, actual 1\n" +
+			"  result 2: expected true, actual false"
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should show the error the function returned", func(t *testing.T) {
+		assertionErr := assertions.ToReturn(func() (int, error) { return 0, fmt.Errorf("load: %w", errBoom) }, 1)
+		want := "the function returned the error \"load: boom\"\n" +
+			"error chain:\n" +
+			"  *fmt.wrapError \"load: boom\"\n" +
+			"    *errors.errorString \"boom\""
+		if assertionErr == nil || assertionErr.Error() != want {
+			t.Errorf("got:\n%v\nwant:\n%s", assertionErr, want)
+		}
+	})
+
+	t.Run("it should compare a returned error as ToEqual does", func(t *testing.T) {
+		assertionErr := assertions.ToReturn(func() error { return errors.New("boom") }, errBoom)
+		if assertionErr != nil {
+			t.Errorf("%v", assertionErr)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package tests_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+func TestToRunFasterThan(t *testing.T) {
+	var tests = []struct {
+		name           string
+		fn             any
+		d              time.Duration
+		iterations     int
+		assertionFails bool
+	}{
+		{name: "a function faster than the duration", fn: func() {}, d: time.Millisecond, iterations: 100, assertionFails: false},
+		{name: "a function slower than the duration", fn: func() { time.Sleep(2 * time.Millisecond) }, d: time.Millisecond, iterations: 5, assertionFails: true},
+		{name: "a function whose odd run is slow", fn: slowOnce(20 * time.Millisecond), d: 5 * time.Millisecond, iterations: 9, assertionFails: false},
+		{name: "no iterations", fn: func() {}, d: time.Millisecond, iterations: 0, assertionFails: true},
+		{name: "a function of another type", fn: func(int) {}, d: time.Millisecond, iterations: 1, assertionFails: true},
+	}
+
+	for _, test := range tests {
+		var testName string
+		if test.assertionFails {
+			testName = fmt.Sprintf("it should fail the assertion, for %s", test.name)
+		} else {
+			testName = fmt.Sprintf("it should not fail the assertion, for %s", test.name)
+		}
+		t.Run(testName, func(t *testing.T) {
+			assertionErr := assertions.ToRunFasterThan(test.fn, test.d, test.iterations)
+			if (assertionErr == nil) == test.assertionFails {
+				t.Errorf("%v", assertionErr)
+			}
+		})
+	}
+
+	t.Run("it should report the median, fastest and slowest runs", func(t *testing.T) {
+		assertionErr := assertions.ToRunFasterThan(func() { time.Sleep(2 * time.Millisecond) }, time.Millisecond, 3)
+		want := regexp.MustCompile(`^the function took \d+(\.\d+)?ms per run, not less than 1ms \(median of 3 runs; fastest \d+(\.\d+)?ms, slowest \d+(\.\d+)?ms\)$`)
+		if assertionErr == nil || !want.MatchString(assertionErr.Error()) {
+			t.Errorf("got:\n%v\nwant it to match:\n%s", assertionErr, want)
+		}
+	})
+}
+
+// slowOnce returns a function that sleeps for d on its second run only, which is
+// the first run to be timed after a one-run warm-up.
+func slowOnce(d time.Duration) func() {
+	runs := 0
+	return func() {
+		runs++
+		if runs == 2 {
+			time.Sleep(d)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package expect
+
+// Matcher is a custom matcher, run with Expect(value).To(matcher). Build one with
+// NewMatcher.
+type Matcher struct {
+	description string
+	match       func(value any) error
+}
+
+// NewMatcher defines a custom matcher. match checks the value passed to Expect
+// and returns nil when it is satisfied, or an error whose message explains the
+// failure. description completes the sentence "expected <value> not to ..." and
+// is used when a negated matcher holds. A custom matcher behaves like a built-in
+// one: it can be negated with Not, made hard with Must, and its failures are
+// reported at the line of the Expect call.
+//
+//	func BeEven() expect.Matcher {
+//		return expect.NewMatcher("be even", func(value any) error {
+//			if n, ok := value.(int); !ok || n%2 != 0 {
+//				return fmt.Errorf("expected %#v to be an even int", value)
+//			}
+//			return nil
+//		})
+//	}
+func NewMatcher(description string, match func(value any) error) Matcher {
+	return Matcher{description: description, match: match}
+}
@@ -0,0 +1,96 @@
+package expect
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func beEven() Matcher {
+	return NewMatcher("be even", func(value any) error {
+		if n, ok := value.(int); !ok || n%2 != 0 {
+			return errors.New("not even")
+		}
+		return nil
+	})
+}
+
+// TestCustomMatcher checks that a matcher built with NewMatcher goes through the
+// same reporting path as the built-in ones, so Not and Must apply to it.
+func TestCustomMatcher(t *testing.T) {
+	cases := []struct {
+		name          string
+		act           func() *spyT
+		wantFailed    bool
+		wantFailedNow bool
+	}{
+		{"To passes when the matcher is satisfied", func() *spyT {
+			e, s := newExpectation(2)
+			e.To(beEven())
+			return s
+		}, false, false},
+		{"To fails when the matcher is not satisfied", func() *spyT {
+			e, s := newExpectation(3)
+			e.To(beEven())
+			return s
+		}, true, false},
+		{"Not().To passes when the matcher is not satisfied", func() *spyT {
+			e, s := newExpectation(3)
+			e.Not().To(beEven())
+			return s
+		}, false, false},
+		{"Not().To fails when the matcher is satisfied", func() *spyT {
+			e, s := newExpectation(2)
+			e.Not().To(beEven())
+			return s
+		}, true, false},
+		{"Must().To stops the test when the matcher is not satisfied", func() *spyT {
+			e, s := newExpectation(3)
+			e.Must().To(beEven())
+			return s
+		}, true, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			spy := c.act()
+			if spy.failed != c.wantFailed {
+				t.Errorf("failed = %v, want %v", spy.failed, c.wantFailed)
+			}
+			if spy.failedNow != c.wantFailedNow {
+				t.Errorf("FailNow() called = %v, want %v", spy.failedNow, c.wantFailedNow)
+			}
+		})
+	}
+}
+
+// TestMatcherAsPredicate checks that a Matcher can be the predicate of the
+// collection matchers, with its failures shown for each element.
+func TestMatcherAsPredicate(t *testing.T) {
+	t.Run("ToAllSatisfy passes when every element satisfies the matcher", func(t *testing.T) {
+		e, spy := newExpectation([]int{2, 4})
+		e.ToAllSatisfy(beEven())
+		if spy.failed {
+			t.Errorf("failed with:\n%s", spy.output.String())
+		}
+	})
+
+	t.Run("ToAllSatisfy shows the failure of the matcher for each element", func(t *testing.T) {
+		e, spy := newExpectation([]int{2, 3})
+		e.ToAllSatisfy(beEven())
+		if !spy.failed {
+			t.Fatalf("did not fail")
+		}
+		if !strings.Contains(spy.output.String(), "[1]: 3: not even") {
+			t.Errorf("output does not point at [1]: 3:\n%s", spy.output.String())
+		}
+	})
+
+	t.Run("Not().ToHaveSomeSatisfying fails when an element satisfies the matcher", func(t *testing.T) {
+		e, spy := newExpectation([]int{1, 2})
+		e.Not().ToHaveSomeSatisfying(beEven())
+		if !spy.failed {
+			t.Errorf("did not fail")
+		}
+	})
+}
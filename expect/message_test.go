@@ -0,0 +1,53 @@
+package expect
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestWithMessage checks that the message given to WithMessage is printed between
+// the location of the failed expectation and the matcher's own explanation.
+func TestWithMessage(t *testing.T) {
+	cases := []struct {
+		name       string
+		act        func() *spyT
+		wantOutput []string
+	}{
+		{"a failing matcher prints the message first", func() *spyT {
+			e, s := newExpectation(3)
+			e.WithMessage("row %d", 7).ToEqual(4)
+			return s
+		}, []string{"  row 7", "  not equal:"}},
+		{"a failing negated matcher prints the message first", func() *spyT {
+			e, s := newExpectation(3)
+			e.WithMessage("row %d", 7).Not().ToEqual(3)
+			return s
+		}, []string{"  row 7", "  expected 3 not to equal 3"}},
+		{"a passing matcher prints nothing", func() *spyT {
+			e, s := newExpectation(3)
+			e.WithMessage("row %d", 7).ToEqual(3)
+			return s
+		}, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			spy := c.act()
+			lines := strings.Split(spy.output.String(), "\n")
+			if c.wantOutput == nil {
+				if spy.output.Len() != 0 {
+					t.Errorf("printed %q, want nothing", spy.output.String())
+				}
+				return
+			}
+			if len(lines) < 1+len(c.wantOutput) {
+				t.Fatalf("printed %q, want the location followed by %q", spy.output.String(), c.wantOutput)
+			}
+			for i, want := range c.wantOutput {
+				if got := lines[1+i]; got != want {
+					t.Errorf("line %d = %q, want %q", 1+i, got, want)
+				}
+			}
+		})
+	}
+}
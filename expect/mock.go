@@ -0,0 +1,202 @@
+package expect
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Mock is a mock function of type F, made with Fn. It records every call made
+// through Func, and returns the values it was configured with through Returns and
+// ReturnsOnce, or the zero values of its results. Check its calls with the
+// ToHaveBeenCalled matchers:
+//
+//	save := expect.Fn[func(user User) error]().Returns(nil)
+//	store := Store{Save: save.Func()}
+//	store.Register("ada")
+//	Expect(save).ToHaveBeenCalledWith(User{Name: "ada"})
+type Mock[F any] struct {
+	fn     F
+	fnType reflect.Type
+
+	mu          sync.Mutex
+	calls       []Call
+	returns     []reflect.Value
+	returnsOnce [][]reflect.Value
+}
+
+// Call is a call recorded by a Mock: the arguments it was called with, variadic
+// ones spread out, and the values it returned.
+type Call struct {
+	Args    []any
+	Returns []any
+}
+
+// Fn returns a mock function of the function type F. It panics if F is not a
+// function type.
+func Fn[F any]() *Mock[F] {
+	fnType := reflect.TypeOf((*F)(nil)).Elem()
+	if fnType.Kind() != reflect.Func {
+		panic(fmt.Sprintf("expect.Fn: %s is not a function type", fnType))
+	}
+	mock := &Mock[F]{fnType: fnType}
+	mock.fn = reflect.MakeFunc(fnType, mock.call).Interface().(F)
+	return mock
+}
+
+// Func returns the mock function, to be passed to the code under test.
+func (m *Mock[F]) Func() F {
+	return m.fn
+}
+
+// Returns sets the values the mock function returns, one for each of its
+// results, whenever no ReturnsOnce values are pending. It panics if the values
+// don't fit the results.
+func (m *Mock[F]) Returns(values ...any) *Mock[F] {
+	results := m.resultValues(values)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.returns = results
+	return m
+}
+
+// ReturnsOnce queues values for the mock function to return on a single call.
+// Queued values are returned in the order they were queued, before falling back
+// to the Returns values. It panics if the values don't fit the results.
+func (m *Mock[F]) ReturnsOnce(values ...any) *Mock[F] {
+	results := m.resultValues(values)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.returnsOnce = append(m.returnsOnce, results)
+	return m
+}
+
+// Calls returns the calls made to the mock function so far, oldest first.
+func (m *Mock[F]) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call{}, m.calls...)
+}
+
+// GoString describes the mock in failure messages.
+func (m *Mock[F]) GoString() string {
+	return fmt.Sprintf("mock %s", m.fnType)
+}
+
+func (m *Mock[F]) callArgs() [][]any {
+	calls := m.Calls()
+	args := make([][]any, len(calls))
+	for i, call := range calls {
+		args[i] = call.Args
+	}
+	return args
+}
+
+func (m *Mock[F]) call(args []reflect.Value) []reflect.Value {
+	call := Call{}
+	for i, arg := range args {
+		if m.fnType.IsVariadic() && i == len(args)-1 {
+			for j := 0; j < arg.Len(); j++ {
+				call.Args = append(call.Args, arg.Index(j).Interface())
+			}
+			continue
+		}
+		call.Args = append(call.Args, arg.Interface())
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	results := m.returns
+	if len(m.returnsOnce) > 0 {
+		results = m.returnsOnce[0]
+		m.returnsOnce = m.returnsOnce[1:]
+	}
+	if results == nil {
+		results = make([]reflect.Value, m.fnType.NumOut())
+		for i := range results {
+			results[i] = reflect.Zero(m.fnType.Out(i))
+		}
+	}
+	for _, result := range results {
+		call.Returns = append(call.Returns, result.Interface())
+	}
+	m.calls = append(m.calls, call)
+	return results
+}
+
+// resultValues converts values to the result types of the mock function.
+func (m *Mock[F]) resultValues(values []any) []reflect.Value {
+	if len(values) != m.fnType.NumOut() {
+		panic(fmt.Sprintf("expect.Fn: %s returns %d values, but %d were given", m.fnType, m.fnType.NumOut(), len(values)))
+	}
+	results := make([]reflect.Value, len(values))
+	for i, value := range values {
+		resultType := m.fnType.Out(i)
+		switch {
+		case value == nil:
+			switch resultType.Kind() {
+			case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+				results[i] = reflect.Zero(resultType)
+			default:
+				panic(fmt.Sprintf("expect.Fn: result %d of %s is a %s, which can't be nil", i+1, m.fnType, resultType))
+			}
+		case reflect.TypeOf(value).AssignableTo(resultType):
+			results[i] = reflect.New(resultType).Elem()
+			results[i].Set(reflect.ValueOf(value))
+		case isNumeric(reflect.TypeOf(value)) && isNumeric(resultType) && fitsExactly(reflect.ValueOf(value), resultType):
+			// Lets an untyped constant, such as the 3 of Returns(3), fit any
+			// numeric result type that holds it, as the compiler would.
+			results[i] = reflect.ValueOf(value).Convert(resultType)
+		default:
+			panic(fmt.Sprintf("expect.Fn: result %d of %s is a %s, but %#v was given", i+1, m.fnType, resultType, value))
+		}
+	}
+	return results
+}
+
+// mockFunction is implemented by every Mock, whatever its function type, so the
+// call matchers can read the calls of any of them.
+type mockFunction interface {
+	callArgs() [][]any
+}
+
+// callArgsOf returns the arguments of the calls recorded by a mock function.
+func callArgsOf(value any) ([][]any, error) {
+	mock, ok := value.(mockFunction)
+	if !ok {
+		return nil, fmt.Errorf("%#v is not a mock function made with expect.Fn", value)
+	}
+	return mock.callArgs(), nil
+}
+
+// fitsExactly reports whether the number value converts to the numeric type typ
+// without losing anything: it must convert back to itself with the same sign, so
+// nothing overflowed, wrapped or was truncated. A float converting to a float
+// type only has to be in range, as a constant would be rounded to it.
+func fitsExactly(value reflect.Value, typ reflect.Type) bool {
+	converted := value.Convert(typ)
+	if value.CanFloat() && converted.CanFloat() {
+		return !converted.OverflowFloat(value.Float())
+	}
+	return converted.Convert(value.Type()).Equal(value) && isNegative(converted) == isNegative(value)
+}
+
+func isNegative(number reflect.Value) bool {
+	switch {
+	case number.CanInt():
+		return number.Int() < 0
+	case number.CanFloat():
+		return number.Float() < 0
+	}
+	return false
+}
+
+func isNumeric(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
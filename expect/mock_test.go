@@ -0,0 +1,101 @@
+package expect
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFn(t *testing.T) {
+	t.Run("it records the arguments and results of every call, spreading variadic arguments", func(t *testing.T) {
+		mock := Fn[func(format string, args ...any) string]()
+		mock.Func()("a")
+		mock.Func()("b %d %d", 1, 2)
+		want := []Call{
+			{Args: []any{"a"}, Returns: []any{""}},
+			{Args: []any{"b %d %d", 1, 2}, Returns: []any{""}},
+		}
+		if got := mock.Calls(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Calls() = %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("it returns the ReturnsOnce values in order, then the Returns values", func(t *testing.T) {
+		errFailed := errors.New("failed")
+		mock := Fn[func() (int64, error)]().Returns(3, nil).ReturnsOnce(1, errFailed).ReturnsOnce(2, nil)
+		got := [][]any{}
+		for i := 0; i < 4; i++ {
+			n, err := mock.Func()()
+			got = append(got, []any{n, err})
+		}
+		want := [][]any{{int64(1), errFailed}, {int64(2), nil}, {int64(3), nil}, {int64(3), nil}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("returned %#v, want %#v", got, want)
+		}
+	})
+
+	t.Run("it converts numbers that fit the numeric result types exactly", func(t *testing.T) {
+		mock := Fn[func() (uint8, float32, int, float64)]().Returns(255, 0.1, 3.0, 7)
+		a, b, c, d := mock.Func()()
+		if a != 255 || b != float32(0.1) || c != 3 || d != 7 {
+			t.Errorf("returned %v, %v, %v, %v, want 255, 0.1, 3, 7", a, b, c, d)
+		}
+	})
+
+	panics := []struct {
+		name string
+		act  func()
+	}{
+		{"it panics for a type that is not a function", func() { Fn[int]() }},
+		{"it panics for the wrong number of return values", func() { Fn[func() error]().Returns(nil, nil) }},
+		{"it panics for a return value of the wrong type", func() { Fn[func() int]().Returns("3") }},
+		{"it panics for a nil return value of a non-nillable type", func() { Fn[func() int]().ReturnsOnce(nil) }},
+		{"it panics for a float with a fraction returned as an int", func() { Fn[func() int]().Returns(3.7) }},
+		{"it panics for a negative number returned as a uint", func() { Fn[func() uint]().Returns(-1) }},
+		{"it panics for a number that overflows the result type", func() { Fn[func() int8]().Returns(200) }},
+		{"it panics for a float that overflows a float32", func() { Fn[func() float32]().Returns(1e300) }},
+	}
+	for _, c := range panics {
+		t.Run(c.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("it did not panic")
+				}
+			}()
+			c.act()
+		})
+	}
+}
+
+// TestCallMatchers checks that the call matchers read the calls of any mock,
+// and fail for values that are not mocks.
+func TestCallMatchers(t *testing.T) {
+	mock := Fn[func(name string, age int)]()
+	mock.Func()("ada", 36)
+	mock.Func()("grace", 40)
+
+	cases := []struct {
+		name       string
+		act        func(e *expectation)
+		value      any
+		wantFailed bool
+	}{
+		{"ToHaveBeenCalled passes on a called mock", func(e *expectation) { e.ToHaveBeenCalled() }, mock, false},
+		{"ToHaveBeenCalled fails on a mock never called", func(e *expectation) { e.ToHaveBeenCalled() }, Fn[func()](), true},
+		{"ToHaveBeenCalledTimes passes on the number of calls", func(e *expectation) { e.ToHaveBeenCalledTimes(2) }, mock, false},
+		{"ToHaveBeenCalledWith passes on the arguments of any call", func(e *expectation) { e.ToHaveBeenCalledWith("ada", 36) }, mock, false},
+		{"ToHaveBeenLastCalledWith fails on the arguments of an earlier call", func(e *expectation) { e.ToHaveBeenLastCalledWith("ada", 36) }, mock, true},
+		{"Not().ToHaveBeenCalledWith passes on arguments of no call", func(e *expectation) { e.Not().ToHaveBeenCalledWith("ada", 40) }, mock, false},
+		{"a value that is not a mock fails", func(e *expectation) { e.ToHaveBeenCalled() }, func() {}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			e, spy := newExpectation(c.value)
+			c.act(e)
+			if spy.failed != c.wantFailed {
+				t.Errorf("failed = %v, want %v", spy.failed, c.wantFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package expect
+
+import "testing"
+
+// TestMust checks that a Must expectation stops the test (FailNow) when its
+// matcher fails, keeps the soft Fail otherwise, and combines with Not.
+func TestMust(t *testing.T) {
+	cases := []struct {
+		name          string
+		act           func() *spyT
+		wantFailed    bool
+		wantFailedNow bool
+	}{
+		{"Must().ToBeNil passes on nil", func() *spyT {
+			e, s := newExpectation(nil)
+			e.Must().ToBeNil()
+			return s
+		}, false, false},
+		{"Must().ToBeNil stops the test on non-nil", func() *spyT {
+			e, s := newExpectation("x")
+			e.Must().ToBeNil()
+			return s
+		}, true, true},
+		{"ToBeNil without Must only marks the test as failed", func() *spyT {
+			e, s := newExpectation("x")
+			e.ToBeNil()
+			return s
+		}, true, false},
+		{"Must().Not().ToBeNil stops the test on nil", func() *spyT {
+			e, s := newExpectation(nil)
+			e.Must().Not().ToBeNil()
+			return s
+		}, true, true},
+		{"Not().Must().ToBeNil stops the test on nil", func() *spyT {
+			e, s := newExpectation(nil)
+			e.Not().Must().ToBeNil()
+			return s
+		}, true, true},
+		{"Must().NotToEqual stops the test on equal", func() *spyT {
+			e, s := newExpectation(3)
+			e.Must().NotToEqual(3)
+			return s
+		}, true, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			spy := c.act()
+			if spy.failed != c.wantFailed {
+				t.Errorf("failed = %v, want %v", spy.failed, c.wantFailed)
+			}
+			if spy.failedNow != c.wantFailedNow {
+				t.Errorf("FailNow() called = %v, want %v", spy.failedNow, c.wantFailedNow)
+			}
+		})
+	}
+}
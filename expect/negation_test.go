@@ -1,13 +1,28 @@
 package expect
 
-import "testing"
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+// spyT is a minimal tFailer that records whether Fail or FailNow was called, and
+// what the expectation printed, so we can test the negation logic without failing
+// the real test.
+type spyT struct {
+	failed, failedNow bool
+	output            bytes.Buffer
+}
 
-// spyT is a minimal tFailer that records whether Fail was called, so we can test
-// the negation logic without failing the real test.
-type spyT struct{ failed bool }
+func (s *spyT) Output() io.Writer { return &s.output }
 
 func (s *spyT) Fail() { s.failed = true }
 
+func (s *spyT) FailNow() {
+	s.failed = true
+	s.failedNow = true
+}
+
 func newExpectation(value any) (*expectation, *spyT) {
 	spy := &spyT{}
 	return &expectation{t: spy, checkExpectationAgainst: value}, spy
@@ -0,0 +1,69 @@
+package expect
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// polling is how an Eventually or Consistently expectation checks its matcher:
+// against the values of a producer, called every interval until timeout.
+type polling struct {
+	timeout      time.Duration
+	interval     time.Duration
+	consistently bool
+}
+
+// run calls producer and checks each value it returns with failure, which returns
+// "" when the value gives the expected outcome and otherwise explains why it
+// doesn't. run returns the explanation of the expectation's failure, or "" when
+// it holds.
+func (p *polling) run(producer any, failure func(value any) string) string {
+	produce, err := producerOf(producer, p.name())
+	if err != nil {
+		return err.Error()
+	}
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		value := produce()
+		explanation := failure(value)
+		if p.consistently && explanation != "" {
+			return fmt.Sprintf(
+				"%s\nstopped holding on attempt %d, %v in; value: %#v",
+				explanation, attempt, time.Since(start).Round(time.Millisecond), value,
+			)
+		}
+		if !p.consistently && explanation == "" {
+			return ""
+		}
+		if time.Since(start) >= p.timeout {
+			if p.consistently {
+				return ""
+			}
+			return fmt.Sprintf(
+				"%s\nstill failing after %d attempts in %v; last value: %#v",
+				explanation, attempt, p.timeout, value,
+			)
+		}
+		time.Sleep(p.interval)
+	}
+}
+
+func (p *polling) name() string {
+	if p.consistently {
+		return "Consistently"
+	}
+	return "Eventually"
+}
+
+// producerOf wraps a function with no arguments and one result, of any type, as
+// a func() any.
+func producerOf(producer any, pollingName string) (func() any, error) {
+	fn := reflect.ValueOf(producer)
+	if fn.Kind() != reflect.Func || fn.IsNil() || fn.Type().NumIn() != 0 || fn.Type().NumOut() != 1 {
+		return nil, fmt.Errorf("%s expects a function with no arguments and one result, but got %#v", pollingName, producer)
+	}
+	return func() any {
+		return fn.Call(nil)[0].Interface()
+	}, nil
+}
@@ -0,0 +1,76 @@
+package expect
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// counter returns a producer whose n-th call returns n.
+func counter() func() int {
+	calls := 0
+	return func() int {
+		calls++
+		return calls
+	}
+}
+
+// TestPolling checks that Eventually and Consistently apply a matcher to the
+// values of a producer, and report the value and attempt at which they failed.
+func TestPolling(t *testing.T) {
+	const timeout, interval = 20 * time.Millisecond, time.Millisecond
+	cases := []struct {
+		name       string
+		act        func() *spyT
+		wantFailed bool
+		wantOutput string
+	}{
+		{"Eventually passes once a value satisfies the matcher", func() *spyT {
+			e, s := newExpectation(counter())
+			e.Eventually(timeout, interval).ToEqual(3)
+			return s
+		}, false, ""},
+		{"Eventually fails when no value satisfies the matcher in time", func() *spyT {
+			e, s := newExpectation(func() int { return 1 })
+			e.Eventually(timeout, interval).ToEqual(2)
+			return s
+		}, true, "still failing after"},
+		{"Not().Eventually passes once a value no longer satisfies the matcher", func() *spyT {
+			e, s := newExpectation(counter())
+			e.Not().Eventually(timeout, interval).ToBeLessThan(3)
+			return s
+		}, false, ""},
+		{"Consistently passes when every value satisfies the matcher", func() *spyT {
+			e, s := newExpectation(func() int { return 1 })
+			e.Consistently(timeout, interval).ToEqual(1)
+			return s
+		}, false, ""},
+		{"Consistently fails at the first value that doesn't satisfy the matcher", func() *spyT {
+			e, s := newExpectation(counter())
+			e.Consistently(timeout, interval).ToBeLessThan(3)
+			return s
+		}, true, "stopped holding on attempt 3"},
+		{"a producer with arguments fails the expectation", func() *spyT {
+			e, s := newExpectation(func(int) int { return 1 })
+			e.Eventually(timeout, interval).ToEqual(1)
+			return s
+		}, true, "Eventually expects a function with no arguments and one result"},
+		{"a value that is not a function fails the expectation, even negated", func() *spyT {
+			e, s := newExpectation(1)
+			e.Not().Consistently(timeout, interval).ToEqual(2)
+			return s
+		}, true, "Consistently expects a function with no arguments and one result"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			spy := c.act()
+			if spy.failed != c.wantFailed {
+				t.Errorf("failed = %v, want %v", spy.failed, c.wantFailed)
+			}
+			if !strings.Contains(spy.output.String(), c.wantOutput) {
+				t.Errorf("printed %q, want it to contain %q", spy.output.String(), c.wantOutput)
+			}
+		})
+	}
+}
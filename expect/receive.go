@@ -0,0 +1,54 @@
+package expect
+
+import (
+	"reflect"
+
+	"github.com/redjolr/goherent/expect/internal/assertions"
+)
+
+// matchReceived applies the args of ToReceive to a received value: a pointer the
+// value can be assigned to captures it, a Matcher checks it, and any other arg is
+// a value it must equal.
+func matchReceived(received any, args []any) error {
+	for _, arg := range args {
+		if capture, ok := capturingPointer(arg, received); ok {
+			if received == nil {
+				capture.Set(reflect.Zero(capture.Type()))
+			} else {
+				capture.Set(reflect.ValueOf(received))
+			}
+			continue
+		}
+		var err error
+		if matcher, ok := arg.(Matcher); ok {
+			err = matcher.match(received)
+		} else {
+			err = assertions.ToEqual(arg, received)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// capturingPointer returns the variable arg points to, if arg is a non-nil
+// pointer to a variable that received can be assigned to.
+func capturingPointer(arg, received any) (reflect.Value, bool) {
+	argValue := reflect.ValueOf(arg)
+	if argValue.Kind() != reflect.Pointer || argValue.IsNil() {
+		return reflect.Value{}, false
+	}
+	variable := argValue.Elem()
+	if received == nil {
+		switch variable.Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			return variable, true
+		}
+		return reflect.Value{}, false
+	}
+	if !reflect.TypeOf(received).AssignableTo(variable.Type()) {
+		return reflect.Value{}, false
+	}
+	return variable, true
+}
@@ -0,0 +1,97 @@
+package expect
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestToReceive checks how ToReceive applies its args to the received value:
+// pointers capture it, matchers and other values check it.
+func TestToReceive(t *testing.T) {
+	const within = 10 * time.Millisecond
+	withValue := func(value any) chan any {
+		ch := make(chan any, 1)
+		ch <- value
+		return ch
+	}
+
+	t.Run("a pointer captures the received value", func(t *testing.T) {
+		var received int
+		e, s := newExpectation(withValue(3))
+		e.ToReceive(within, &received)
+		if s.failed || received != 3 {
+			t.Errorf("failed = %v, received = %v, want no failure and 3", s.failed, received)
+		}
+	})
+
+	t.Run("a pointer captures a received nil error", func(t *testing.T) {
+		errs := make(chan error, 1)
+		errs <- nil
+		received := errors.New("not captured")
+		e, s := newExpectation(errs)
+		e.ToReceive(within, &received)
+		if s.failed || received != nil {
+			t.Errorf("failed = %v, received = %v, want no failure and nil", s.failed, received)
+		}
+	})
+
+	cases := []struct {
+		name       string
+		act        func() *spyT
+		wantFailed bool
+		wantOutput string
+	}{
+		{"a value equal to the received one passes", func() *spyT {
+			e, s := newExpectation(withValue(3))
+			e.ToReceive(within, 3)
+			return s
+		}, false, ""},
+		{"a value different from the received one fails", func() *spyT {
+			e, s := newExpectation(withValue(3))
+			e.ToReceive(within, 4)
+			return s
+		}, true, "received 3 from chan interface {} (0 of 1 buffered), but:"},
+		{"an error value is compared, not captured", func() *spyT {
+			errs := make(chan error, 1)
+			errs <- io.EOF
+			e, s := newExpectation(errs)
+			e.ToReceive(within, io.EOF)
+			return s
+		}, false, ""},
+		{"a matcher checks the received value", func() *spyT {
+			e, s := newExpectation(withValue(3))
+			e.ToReceive(within, beEven())
+			return s
+		}, true, "not even"},
+		{"nothing received fails", func() *spyT {
+			e, s := newExpectation(make(chan int))
+			e.ToReceive(within)
+			return s
+		}, true, "nothing was received from chan int (unbuffered) within 10ms"},
+		{"Not() passes when nothing is received", func() *spyT {
+			e, s := newExpectation(make(chan int))
+			e.Not().ToReceive(within)
+			return s
+		}, false, ""},
+		{"Not() fails when a value is received and shows the channel", func() *spyT {
+			e, s := newExpectation(withValue(3))
+			e.Not().ToReceive(within)
+			return s
+		}, true, "expected chan interface {} (0 of 1 buffered) not to receive a value within 10ms"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			spy := c.act()
+			if spy.failed != c.wantFailed {
+				t.Errorf("failed = %v, want %v", spy.failed, c.wantFailed)
+			}
+			if !strings.Contains(spy.output.String(), c.wantOutput) {
+				t.Errorf("printed %q, want it to contain %q", spy.output.String(), c.wantOutput)
+			}
+		})
+	}
+}
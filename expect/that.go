@@ -0,0 +1,189 @@
+package expect
+
+import (
+	"cmp"
+	"fmt"
+	"testing"
+)
+
+// That returns a type-safe expectation on value: its matchers take values of
+// type T, so comparing value with one of another type is a compile error rather
+// than a confusing failure. It runs the same assertions as Expect, through the
+// same negation path, with Not, Must and WithMessage as well. ThatOrdered and
+// ThatSlice add the matchers that only make sense for ordered values and
+// slices.
+//
+//	expect.That(t, count).ToEqual(3)
+//	expect.That(t, count).ToEqual("3") // does not compile
+func That[T any](t testing.TB, value T) *typedExpectation[T] {
+	return &typedExpectation[T]{expectation: expect(t)(value), value: value}
+}
+
+// ThatOrdered is That for a value of an ordered type, with the ordering
+// matchers.
+//
+//	expect.ThatOrdered(t, latency).ToBeLessThan(time.Second)
+func ThatOrdered[T cmp.Ordered](t testing.TB, value T) *orderedExpectation[T] {
+	return &orderedExpectation[T]{*That(t, value)}
+}
+
+// ThatSlice is That for a slice, with the collection matchers, typed on its
+// elements.
+//
+//	expect.ThatSlice(t, names).ToContainElement("ada")
+func ThatSlice[S ~[]E, E any](t testing.TB, value S) *sliceExpectation[S, E] {
+	return &sliceExpectation[S, E]{*That(t, value)}
+}
+
+type typedExpectation[T any] struct {
+	expectation *expectation
+	value       T
+}
+
+// Not returns the expectation with its matchers negated, as Expect's Not does.
+func (e *typedExpectation[T]) Not() *typedExpectation[T] {
+	return &typedExpectation[T]{e.expectation.Not(), e.value}
+}
+
+// Must returns a hard expectation, as Expect's Must does.
+func (e *typedExpectation[T]) Must() *typedExpectation[T] {
+	return &typedExpectation[T]{e.expectation.Must(), e.value}
+}
+
+// WithMessage returns an expectation whose failure output starts with the given
+// message, as Expect's WithMessage does.
+func (e *typedExpectation[T]) WithMessage(format string, args ...any) *typedExpectation[T] {
+	return &typedExpectation[T]{e.expectation.WithMessage(format, args...), e.value}
+}
+
+// To runs a custom matcher against the value.
+func (e *typedExpectation[T]) To(matcher Matcher) {
+	e.expectation.To(matcher)
+}
+
+// ToEqual expects the value to be deeply equal to expected.
+func (e *typedExpectation[T]) ToEqual(expected T, options ...EqualOption) {
+	e.expectation.ToEqual(expected, options...)
+}
+
+// ToBeNil expects the value to be nil, such as a nil pointer, slice or map.
+func (e *typedExpectation[T]) ToBeNil() {
+	e.expectation.ToBeNil()
+}
+
+// ToSatisfy expects the value to satisfy predicate.
+//
+//	expect.That(t, user).ToSatisfy(func(u User) bool { return u.Active })
+func (e *typedExpectation[T]) ToSatisfy(predicate func(T) bool) {
+	e.expectation.To(NewMatcher("satisfy the predicate", func(value any) error {
+		if !predicate(e.value) {
+			return fmt.Errorf("%#v does not satisfy the predicate", value)
+		}
+		return nil
+	}))
+}
+
+type orderedExpectation[T cmp.Ordered] struct {
+	typedExpectation[T]
+}
+
+// Not returns the expectation with its matchers negated, as Expect's Not does.
+func (e *orderedExpectation[T]) Not() *orderedExpectation[T] {
+	return &orderedExpectation[T]{*e.typedExpectation.Not()}
+}
+
+// Must returns a hard expectation, as Expect's Must does.
+func (e *orderedExpectation[T]) Must() *orderedExpectation[T] {
+	return &orderedExpectation[T]{*e.typedExpectation.Must()}
+}
+
+// WithMessage returns an expectation whose failure output starts with the given
+// message, as Expect's WithMessage does.
+func (e *orderedExpectation[T]) WithMessage(format string, args ...any) *orderedExpectation[T] {
+	return &orderedExpectation[T]{*e.typedExpectation.WithMessage(format, args...)}
+}
+
+func (e *orderedExpectation[T]) ToBeGreaterThan(other T) {
+	e.expectation.ToBeGreaterThan(other)
+}
+
+func (e *orderedExpectation[T]) ToBeGreaterThanOrEqualTo(other T) {
+	e.expectation.ToBeGreaterThanOrEqualTo(other)
+}
+
+func (e *orderedExpectation[T]) ToBeLessThan(other T) {
+	e.expectation.ToBeLessThan(other)
+}
+
+func (e *orderedExpectation[T]) ToBeLessThanOrEqualTo(other T) {
+	e.expectation.ToBeLessThanOrEqualTo(other)
+}
+
+type sliceExpectation[S ~[]E, E any] struct {
+	typedExpectation[S]
+}
+
+// Not returns the expectation with its matchers negated, as Expect's Not does.
+func (e *sliceExpectation[S, E]) Not() *sliceExpectation[S, E] {
+	return &sliceExpectation[S, E]{*e.typedExpectation.Not()}
+}
+
+// Must returns a hard expectation, as Expect's Must does.
+func (e *sliceExpectation[S, E]) Must() *sliceExpectation[S, E] {
+	return &sliceExpectation[S, E]{*e.typedExpectation.Must()}
+}
+
+// WithMessage returns an expectation whose failure output starts with the given
+// message, as Expect's WithMessage does.
+func (e *sliceExpectation[S, E]) WithMessage(format string, args ...any) *sliceExpectation[S, E] {
+	return &sliceExpectation[S, E]{*e.typedExpectation.WithMessage(format, args...)}
+}
+
+func (e *sliceExpectation[S, E]) ToContainElement(element E) {
+	e.expectation.ToContainElement(element)
+}
+
+func (e *sliceExpectation[S, E]) ToContainAll(elements ...E) {
+	e.expectation.ToContainAll(anys(elements)...)
+}
+
+func (e *sliceExpectation[S, E]) ToContainExactlyInAnyOrder(elements ...E) {
+	e.expectation.ToContainExactlyInAnyOrder(anys(elements)...)
+}
+
+func (e *sliceExpectation[S, E]) ToAllSatisfy(predicate func(E) bool) {
+	e.expectation.ToAllSatisfy(predicate)
+}
+
+func (e *sliceExpectation[S, E]) ToHaveSomeSatisfying(predicate func(E) bool) {
+	e.expectation.ToHaveSomeSatisfying(predicate)
+}
+
+func (e *sliceExpectation[S, E]) ToBeSortedBy(less func(a, b E) bool) {
+	e.expectation.ToBeSortedBy(less)
+}
+
+func (e *sliceExpectation[S, E]) ToHaveUniqueElements() {
+	e.expectation.ToHaveUniqueElements()
+}
+
+func (e *sliceExpectation[S, E]) ToHaveLength(length int) {
+	e.expectation.ToHaveLength(length)
+}
+
+func (e *sliceExpectation[S, E]) ToHaveLengthGreaterThan(length int) {
+	e.expectation.ToHaveLengthGreaterThan(length)
+}
+
+func (e *sliceExpectation[S, E]) ToHaveLengthLessThan(length int) {
+	e.expectation.ToHaveLengthLessThan(length)
+}
+
+// anys returns the elements of a typed slice as a []any.
+func anys[E any](elements []E) []any {
+	converted := make([]any, len(elements))
+	for i, element := range elements {
+		converted[i] = element
+	}
+	return converted
+}
@@ -0,0 +1,113 @@
+package expect
+
+import (
+	"strings"
+	"testing"
+)
+
+func newThat[T any](value T) (*typedExpectation[T], *spyT) {
+	e, spy := newExpectation(value)
+	return &typedExpectation[T]{expectation: e, value: value}, spy
+}
+
+// TestThat checks that the typed matchers run the untyped assertions, through the
+// same negation and reporting path.
+func TestThat(t *testing.T) {
+	cases := []struct {
+		name          string
+		act           func() *spyT
+		wantFailed    bool
+		wantFailedNow bool
+	}{
+		{"ToEqual passes on equal", func() *spyT {
+			e, s := newThat(3)
+			e.ToEqual(3)
+			return s
+		}, false, false},
+		{"ToEqual fails on unequal", func() *spyT {
+			e, s := newThat(3)
+			e.ToEqual(4)
+			return s
+		}, true, false},
+		{"Not().ToEqual fails on equal", func() *spyT {
+			e, s := newThat(3)
+			e.Not().ToEqual(3)
+			return s
+		}, true, false},
+		{"Must().ToEqual stops the test on unequal", func() *spyT {
+			e, s := newThat("a")
+			e.Must().ToEqual("b")
+			return s
+		}, true, true},
+		{"ToBeNil passes on a nil pointer", func() *spyT {
+			e, s := newThat[*int](nil)
+			e.ToBeNil()
+			return s
+		}, false, false},
+		{"ToSatisfy passes when the predicate holds", func() *spyT {
+			e, s := newThat(4)
+			e.ToSatisfy(func(n int) bool { return n%2 == 0 })
+			return s
+		}, false, false},
+		{"ToSatisfy fails when the predicate does not hold", func() *spyT {
+			e, s := newThat(3)
+			e.ToSatisfy(func(n int) bool { return n%2 == 0 })
+			return s
+		}, true, false},
+		{"ToSatisfy passes a nil interface to the predicate", func() *spyT {
+			e, s := newThat[error](nil)
+			e.ToSatisfy(func(err error) bool { return err == nil })
+			return s
+		}, false, false},
+		{"ToBeGreaterThan passes when greater", func() *spyT {
+			e, s := newThat(5)
+			(&orderedExpectation[int]{*e}).ToBeGreaterThan(2)
+			return s
+		}, false, false},
+		{"Not().ToBeLessThan fails when less", func() *spyT {
+			e, s := newThat("a")
+			(&orderedExpectation[string]{*e}).Not().ToBeLessThan("b")
+			return s
+		}, true, false},
+		{"ToContainAll passes when every element is present", func() *spyT {
+			e, s := newThat([]int{1, 2, 3})
+			(&sliceExpectation[[]int, int]{*e}).ToContainAll(3, 1)
+			return s
+		}, false, false},
+		{"ToContainExactlyInAnyOrder fails on a missing element", func() *spyT {
+			e, s := newThat([]int{1, 2})
+			(&sliceExpectation[[]int, int]{*e}).ToContainExactlyInAnyOrder(2, 1, 3)
+			return s
+		}, true, false},
+		{"ToBeSortedBy passes on a sorted slice", func() *spyT {
+			e, s := newThat([]string{"a", "b"})
+			(&sliceExpectation[[]string, string]{*e}).ToBeSortedBy(func(a, b string) bool { return a < b })
+			return s
+		}, false, false},
+		{"Not().ToHaveUniqueElements fails on unique elements", func() *spyT {
+			e, s := newThat([]int{1, 2})
+			(&sliceExpectation[[]int, int]{*e}).Not().ToHaveUniqueElements()
+			return s
+		}, true, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			spy := c.act()
+			if spy.failed != c.wantFailed {
+				t.Errorf("failed = %v, want %v\n%s", spy.failed, c.wantFailed, spy.output.String())
+			}
+			if spy.failedNow != c.wantFailedNow {
+				t.Errorf("FailNow() called = %v, want %v", spy.failedNow, c.wantFailedNow)
+			}
+		})
+	}
+
+	t.Run("a failure points at the line of the typed matcher call", func(t *testing.T) {
+		e, spy := newThat(3)
+		e.ToEqual(4)
+		if !strings.Contains(spy.output.String(), "that_test.go:") {
+			t.Errorf("output does not point at that_test.go:\n%s", spy.output.String())
+		}
+	})
+}
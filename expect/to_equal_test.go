@@ -0,0 +1,60 @@
+package expect
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestToEqualDirection checks, end to end, that ToEqual reports the value under
+// test as the actual one and its argument as the expected one, in the header
+// and in the diff alike.
+func TestToEqualDirection(t *testing.T) {
+	type order struct{ Price int }
+	withValue := func(value any) chan any {
+		ch := make(chan any, 1)
+		ch <- value
+		return ch
+	}
+
+	cases := []struct {
+		name       string
+		act        func() *spyT
+		wantOutput []string
+	}{
+		{"an element the value lacks is missing", func() *spyT {
+			e, s := newExpectation([]int{1, 2})
+			e.ToEqual([]int{1, 2, 3})
+			return s
+		}, []string{"expected: []int{1, 2, 3}", "actual  : []int{1, 2}", "[2]: missing 3"}},
+		{"an element only the value has is unexpected", func() *spyT {
+			e, s := newExpectation([]int{1, 2, 3})
+			e.ToEqual([]int{1, 2})
+			return s
+		}, []string{"expected: []int{1, 2}", "actual  : []int{1, 2, 3}", "[2]: unexpected 3"}},
+		{"a field reads expected, then actual", func() *spyT {
+			e, s := newExpectation(order{Price: 12})
+			e.ToEqual(order{Price: 10})
+			return s
+		}, []string{".Price: expected 10, actual 12"}},
+		{"a received value is the actual one", func() *spyT {
+			e, s := newExpectation(withValue(order{Price: 12}))
+			e.ToReceive(10*time.Millisecond, order{Price: 10})
+			return s
+		}, []string{".Price: expected 10, actual 12"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			spy := c.act()
+			if !spy.failed {
+				t.Fatalf("passed, want a failure")
+			}
+			for _, want := range c.wantOutput {
+				if !strings.Contains(spy.output.String(), want) {
+					t.Errorf("output does not contain %q:\n%s", want, spy.output.String())
+				}
+			}
+		})
+	}
+}
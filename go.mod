@@ -5,6 +5,12 @@ go 1.22.3
 replace github.com/redjolr/goherent => ./.
 
 require (
-	github.com/davecgh/go-spew v1.1.1
 	github.com/pmezard/go-difflib v1.0.0
+	golang.org/x/tools v0.30.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
 )
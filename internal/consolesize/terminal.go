@@ -0,0 +1,15 @@
+package consolesize
+
+import "os"
+
+// IsTerminal reports whether stdout is a terminal: a character device with a
+// console size. Output piped to another program or redirected to a file, or to
+// /dev/null, is not.
+func IsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	_, rows := GetConsoleSize()
+	return rows != 0
+}
@@ -3,3 +3,25 @@ package internal
 const ENCODED_NEWLINE = "%0A"
 const ENCODED_WHITESPACE = "%20"
 const ENCODED_TAB = "&#9;"
+
+// The markers below prefix the encoded name of a goherent subtest that is not a
+// plain test case, so the runner can tell what it is from the name alone.
+
+// ENCODED_DESCRIBE_BLOCK marks a Describe block: a subtest that only groups test
+// cases and is not a test case itself.
+const ENCODED_DESCRIBE_BLOCK = "%7Bdescribe%7D"
+
+// ENCODED_TODO marks a TestTodo placeholder.
+const ENCODED_TODO = "%7Btodo%7D"
+
+// ENCODED_FOCUSED marks a TestOnly test.
+const ENCODED_FOCUSED = "%7Bonly%7D"
+
+// ENCODED_SKIPPED_BY_FOCUS marks a test that was skipped because its package
+// contains TestOnly tests.
+const ENCODED_SKIPPED_BY_FOCUS = "%7Bunfocused%7D"
+
+// COLOR_ENV is the environment variable that turns on colours in the diffs of
+// failed expectations. The runner sets it to "1" on the tests it runs when it
+// writes to a terminal.
+const COLOR_ENV = "GOHERENT_COLOR"
@@ -5,7 +5,11 @@ import (
 )
 
 func DecodeGoherentTestName(encodedTestName string) string {
-	decoded := strings.ReplaceAll(encodedTestName, ENCODED_WHITESPACE, " ")
+	decoded := encodedTestName
+	for _, marker := range []string{ENCODED_DESCRIBE_BLOCK, ENCODED_TODO, ENCODED_FOCUSED, ENCODED_SKIPPED_BY_FOCUS} {
+		decoded = strings.ReplaceAll(decoded, marker, "")
+	}
+	decoded = strings.ReplaceAll(decoded, ENCODED_WHITESPACE, " ")
 	decoded = strings.ReplaceAll(decoded, ENCODED_NEWLINE, "\n")
 	decoded = strings.ReplaceAll(decoded, ENCODED_TAB, "\t")
 	return decoded
@@ -120,6 +120,26 @@ func Test_DecodeGoherentTestName(t *testing.T) {
 				ENCODED_WHITESPACE + ENCODED_TAB + ENCODED_NEWLINE +
 				"dsa" + ENCODED_NEWLINE + ENCODED_TAB + ENCODED_WHITESPACE,
 		},
+		{
+			name:                    "Nested_test_under_a_describe_block",
+			expectedDecodedTestName: "TestX/users api/it works",
+			encodedGoherentTestName: "TestX/" + ENCODED_DESCRIBE_BLOCK + "users" + ENCODED_WHITESPACE + "api/it" + ENCODED_WHITESPACE + "works",
+		},
+		{
+			name:                    "Todo_test",
+			expectedDecodedTestName: "TestX/it works",
+			encodedGoherentTestName: "TestX/" + ENCODED_TODO + "it" + ENCODED_WHITESPACE + "works",
+		},
+		{
+			name:                    "Focused_test",
+			expectedDecodedTestName: "TestX/it works",
+			encodedGoherentTestName: "TestX/" + ENCODED_FOCUSED + "it" + ENCODED_WHITESPACE + "works",
+		},
+		{
+			name:                    "Test_skipped_by_focus",
+			expectedDecodedTestName: "TestX/it works",
+			encodedGoherentTestName: "TestX/" + ENCODED_SKIPPED_BY_FOCUS + "it" + ENCODED_WHITESPACE + "works",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -13,3 +13,28 @@ func EncodeGoherentTestName(testName string) string {
 	testName = tabRegex.ReplaceAllString(testName, strings.Repeat(ENCODED_WHITESPACE, 4))
 	return testName
 }
+
+// EncodeGoherentDescribeName encodes a Describe block's name like a test name,
+// marked with ENCODED_DESCRIBE_BLOCK so it is not reported as a test.
+func EncodeGoherentDescribeName(describeName string) string {
+	return ENCODED_DESCRIBE_BLOCK + EncodeGoherentTestName(describeName)
+}
+
+// EncodeGoherentMarkedTestName encodes a test name like EncodeGoherentTestName,
+// prefixed with one of the ENCODED_* markers.
+func EncodeGoherentMarkedTestName(marker string, testName string) string {
+	return marker + EncodeGoherentTestName(testName)
+}
+
+// IsGoherentDescribeBlock reports whether the innermost segment of an encoded
+// (go test) name is a Describe block rather than a test case.
+func IsGoherentDescribeBlock(encodedTestName string) bool {
+	return HasGoherentMarker(encodedTestName, ENCODED_DESCRIBE_BLOCK)
+}
+
+// HasGoherentMarker reports whether the innermost segment of an encoded (go test)
+// name starts with the given ENCODED_* marker.
+func HasGoherentMarker(encodedTestName string, marker string) bool {
+	segments := strings.Split(encodedTestName, "/")
+	return strings.HasPrefix(segments[len(segments)-1], marker)
+}
@@ -140,3 +140,84 @@ func Test_EncodeGoherentTestName(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsGoherentDescribeBlock(t *testing.T) {
+	type TestCase struct {
+		name            string
+		encodedTestName string
+		isDescribeBlock bool
+	}
+	testCases := []TestCase{
+		{
+			name:            "Top_level_test",
+			encodedTestName: "TestX",
+			isDescribeBlock: false,
+		},
+		{
+			name:            "Test_case",
+			encodedTestName: "TestX/" + EncodeGoherentTestName("it works"),
+			isDescribeBlock: false,
+		},
+		{
+			name:            "Describe_block",
+			encodedTestName: "TestX/" + EncodeGoherentDescribeName("users api"),
+			isDescribeBlock: true,
+		},
+		{
+			name:            "Test_case_inside_a_describe_block",
+			encodedTestName: "TestX/" + EncodeGoherentDescribeName("users api") + "/" + EncodeGoherentTestName("it works"),
+			isDescribeBlock: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if IsGoherentDescribeBlock(testCase.encodedTestName) != testCase.isDescribeBlock {
+				t.Errorf("Expected IsGoherentDescribeBlock(`%s`) to be %v", testCase.encodedTestName, testCase.isDescribeBlock)
+			}
+		})
+	}
+}
+
+func Test_HasGoherentMarker(t *testing.T) {
+	type TestCase struct {
+		name            string
+		encodedTestName string
+		marker          string
+		hasMarker       bool
+	}
+	testCases := []TestCase{
+		{
+			name:            "Todo_test",
+			encodedTestName: "TestX/" + EncodeGoherentMarkedTestName(ENCODED_TODO, "it works"),
+			marker:          ENCODED_TODO,
+			hasMarker:       true,
+		},
+		{
+			name:            "Focused_test_checked_for_the_todo_marker",
+			encodedTestName: "TestX/" + EncodeGoherentMarkedTestName(ENCODED_FOCUSED, "it works"),
+			marker:          ENCODED_TODO,
+			hasMarker:       false,
+		},
+		{
+			name:            "Focused_test_inside_a_describe_block",
+			encodedTestName: "TestX/" + EncodeGoherentDescribeName("users api") + "/" + EncodeGoherentMarkedTestName(ENCODED_FOCUSED, "it works"),
+			marker:          ENCODED_FOCUSED,
+			hasMarker:       true,
+		},
+		{
+			name:            "Test_case_inside_a_focused_parent",
+			encodedTestName: "TestX/" + EncodeGoherentMarkedTestName(ENCODED_FOCUSED, "parent") + "/child",
+			marker:          ENCODED_FOCUSED,
+			hasMarker:       false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if HasGoherentMarker(testCase.encodedTestName, testCase.marker) != testCase.hasMarker {
+				t.Errorf("Expected HasGoherentMarker(`%s`, `%s`) to be %v", testCase.encodedTestName, testCase.marker, testCase.hasMarker)
+			}
+		})
+	}
+}
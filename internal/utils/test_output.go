@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// testOutputIndent is the indentation the testing package puts in front of every
+// line a test writes through its Log or Output methods.
+const testOutputIndent = "    "
+
+// PrintToTest writes text, laid out as it should appear in the go test output,
+// as one block of the test t. When t has its own output stream (the Output
+// method of testing.TB, Go 1.25+), text goes there, so go test attributes it to
+// t even while parallel tests interleave; the indentation the testing package
+// adds is taken off text first, so the layout is the same either way. Otherwise
+// text is written to stdout in a single call, so it is never split by the output
+// of another test.
+func PrintToTest(t any, text string) {
+	if tb, ok := t.(interface{ Output() io.Writer }); ok {
+		lines := strings.Split(text, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimPrefix(line, testOutputIndent)
+		}
+		fmt.Fprint(tb.Output(), strings.Join(lines, "\n"))
+		return
+	}
+	fmt.Print(text)
+}
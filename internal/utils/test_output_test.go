@@ -0,0 +1,34 @@
+package utils_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/redjolr/goherent/internal/utils"
+)
+
+type testWithOutput struct{ out bytes.Buffer }
+
+func (t *testWithOutput) Output() io.Writer { return &t.out }
+
+func TestPrintToTest(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"drops the indentation the testing package adds", "    file.go:3\n      expected 1\n", "file.go:3\n  expected 1\n"},
+		{"keeps lines indented less than the testing package", "  a\nb\n", "  a\nb\n"},
+		{"empty text", "", ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tb := &testWithOutput{}
+			utils.PrintToTest(tb, c.text)
+			if got := tb.out.String(); got != c.want {
+				t.Errorf("PrintToTest(%q) wrote %q, want %q", c.text, got, c.want)
+			}
+		})
+	}
+}
@@ -9,5 +9,8 @@ import (
 func main() {
 	extraCmdArgs := os.Args[1:]
 
+	if len(extraCmdArgs) > 0 && extraCmdArgs[0] == "mock" {
+		os.Exit(cmd.MockMain(extraCmdArgs[1:]))
+	}
 	os.Exit(cmd.Main(extraCmdArgs))
 }
@@ -14,6 +14,7 @@ const ERASE_SCREEN = "\033[0J"
 const RED string = "\033[31m"
 const GREEN string = "\033[32m"
 const YELLOW string = "\u001B[33m"
+const MAGENTA string = "\033[35m"
 const COLOR_RESET string = "\033[0m"
 
 const BOLD string = "\033[1m"
@@ -0,0 +1,27 @@
+package goherent
+
+import (
+	"testing"
+
+	"github.com/redjolr/goherent/internal"
+)
+
+// Describe groups related tests under a shared description. The closure
+// receives the block's own *testing.T, which is passed to the Test calls and
+// hooks inside it; hooks registered there apply only to the block's tests, while
+// hooks of enclosing blocks still apply. Describe blocks can be nested and are
+// not reported as tests themselves.
+//
+//	Describe("the users API", func(t *testing.T) {
+//		BeforeEach(func(Expect expect.F) { db.Reset() }, t)
+//		Test("it lists users", func(Expect expect.F) { ... }, t)
+//	}, t)
+func Describe(name string, describeClosure func(t *testing.T), t *testing.T) {
+	describeName := internal.EncodeGoherentDescribeName(name)
+	parentScope := scopeOf(t)
+	parentScope.runBeforeAll()
+	t.Run(describeName, func(t *testing.T) {
+		registerScope(t, parentScope)
+		describeClosure(t)
+	})
+}
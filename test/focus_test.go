@@ -0,0 +1,115 @@
+package goherent
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+)
+
+func TestFocusedTestFunctions(t *testing.T) {
+	writeTestFile := func(t *testing.T, source string) string {
+		dir := t.TempDir()
+		err := os.WriteFile(filepath.Join(dir, "some_test.go"), []byte(source), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+
+	var tests = []struct {
+		name    string
+		source  string
+		focused []string
+	}{
+		{
+			name:    "a dot-imported TestOnly call",
+			source:  "package p\nimport . \"github.com/redjolr/goherent/test\"\nfunc TestX(t *testing.T) { TestOnly(\"x\", func(Expect expect.F) {}, t) }\n",
+			focused: []string{"TestX"},
+		},
+		{
+			name:    "a package-qualified TestOnly call",
+			source:  "package p\nimport \"github.com/redjolr/goherent/test\"\nfunc TestX(t *testing.T) { goherent.TestOnly(\"x\", func(Expect expect.F) {}, t) }\n",
+			focused: []string{"TestX"},
+		},
+		{
+			name:    "a TestOnly call through a renamed import",
+			source:  "package p\nimport g \"github.com/redjolr/goherent/test\"\nfunc TestX(t *testing.T) { g.TestOnly(\"x\", func(Expect expect.F) {}, t) }\n",
+			focused: []string{"TestX"},
+		},
+		{
+			name:    "an explicitly instantiated TestOnly call",
+			source:  "package goherent\nfunc TestX(t *testing.T) { TestOnly[func(t *T)](\"x\", func(t *T) {}, t) }\n",
+			focused: []string{"TestX"},
+		},
+		{
+			name:    "TestOnly calls in some of the test functions",
+			source:  "package goherent\nfunc TestX(t *testing.T) { Test(\"x\", func(t *T) {}, t) }\nfunc TestY(t *testing.T) { TestOnly(\"y\", func(t *T) {}, t) }\n",
+			focused: []string{"TestY"},
+		},
+		{
+			name:    "only Test calls",
+			source:  "package p\nimport . \"github.com/redjolr/goherent/test\"\nfunc TestX(t *testing.T) { Test(\"x\", func(Expect expect.F) {}, t) }\n",
+			focused: nil,
+		},
+		{
+			name:    "TestOnly mentioned in a comment and a string",
+			source:  "package p\nimport . \"github.com/redjolr/goherent/test\"\n// TestOnly(\"x\")\nfunc TestX(t *testing.T) { Test(\"TestOnly(x)\", func(Expect expect.F) {}, t) }\n",
+			focused: nil,
+		},
+		{
+			name:    "a TestOnly of another package",
+			source:  "package p\nimport \"example.com/other\"\nfunc TestX(t *testing.T) { other.TestOnly(\"x\") }\n",
+			focused: nil,
+		},
+		{
+			name:    "an unqualified TestOnly without a dot-import",
+			source:  "package p\nfunc TestX(t *testing.T) { TestOnly(\"x\") }\n",
+			focused: nil,
+		},
+		{
+			name:    "a TestOnly call in a file excluded by a build tag",
+			source:  "//go:build integration\n\npackage goherent\nfunc TestX(t *testing.T) { TestOnly(\"x\", func(t *T) {}, t) }\n",
+			focused: nil,
+		},
+	}
+
+	for _, test := range tests {
+		Test("it should find the focused test functions for "+test.name, func(Expect expect.F) {
+			Expect(focusedTestFunctions(writeTestFile(t, test.source), build.Default)).ToEqual(test.focused)
+		}, t)
+	}
+
+	Test("it should include a file whose build tag is set", func(Expect expect.F) {
+		ctx := build.Default
+		ctx.BuildTags = []string{"integration"}
+		dir := writeTestFile(t, "//go:build integration\n\npackage goherent\nfunc TestX(t *testing.T) { TestOnly(\"x\", func(t *T) {}, t) }\n")
+		Expect(focusedTestFunctions(dir, ctx)).ToEqual([]string{"TestX"})
+	}, t)
+}
+
+func TestIsTestSelected(t *testing.T) {
+	var tests = []struct {
+		run, skip string
+		selected  bool
+	}{
+		{run: "", skip: "", selected: true},
+		{run: "TestB", skip: "", selected: true},
+		{run: "TestA", skip: "", selected: false},
+		{run: "TestA|TestB", skip: "", selected: true},
+		{run: "TestB/some subtest", skip: "", selected: true},
+		{run: "TestA/TestB", skip: "", selected: false},
+		{run: "Test(A|B)/x", skip: "", selected: true},
+		{run: "", skip: "TestB", selected: false},
+		{run: "", skip: "TestB/some subtest", selected: true},
+		{run: "", skip: "TestA", selected: true},
+	}
+
+	for _, test := range tests {
+		Test("it should select TestB correctly for -run="+test.run+" -skip="+test.skip, func(Expect expect.F) {
+			Expect(isTestSelected("TestB", test.run, test.skip)).ToEqual(test.selected)
+		}, t)
+	}
+}
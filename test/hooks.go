@@ -0,0 +1,300 @@
+package goherent
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+	"github.com/redjolr/goherent/internal/utils"
+	"github.com/redjolr/goherent/terminal/ansi_escape"
+)
+
+// hookScope holds the lifecycle hooks registered on one *testing.T: a top-level
+// test function or a Describe block. Scopes nest, so a test runs the hooks of its
+// own scope and of every enclosing one.
+type hookScope struct {
+	parent *hookScope
+	// t is the *testing.T of the test function or Describe block itself. The
+	// BeforeAll and AfterAll hooks run against it, so what they register with it,
+	// such as a TempDir or a Cleanup, lasts as long as the scope.
+	t *testing.T
+
+	mu         sync.Mutex
+	beforeAll  []testBody
+	beforeEach []testBody
+	afterEach  []testBody
+	afterAll   []testBody
+
+	beforeAllRan bool
+	// beforeAllFailed is set when a BeforeAll hook failed. Every test in the
+	// scope is failed on its account.
+	beforeAllFailed bool
+	testNames       []string
+}
+
+var hookScopes sync.Map // *testing.T -> *hookScope
+
+// scopeOf returns the hook scope of t, creating a top-level one if t has none.
+func scopeOf(t *testing.T) *hookScope {
+	if scope, ok := hookScopes.Load(t); ok {
+		return scope.(*hookScope)
+	}
+	return registerScope(t, nil)
+}
+
+// registerScope creates the hook scope of t, nested in parent (nil for a
+// top-level test function). Its AfterAll hooks run once t and all of its
+// subtests, parallel ones included, have finished.
+func registerScope(t *testing.T, parent *hookScope) *hookScope {
+	scope, loaded := hookScopes.LoadOrStore(t, &hookScope{parent: parent, t: t})
+	if loaded {
+		return scope.(*hookScope)
+	}
+	t.Cleanup(func() {
+		scope.(*hookScope).runAfterAll()
+		hookScopes.Delete(t)
+	})
+	return scope.(*hookScope)
+}
+
+// BeforeAll registers a hook that runs once, before the first test or Describe
+// block of the enclosing test function or Describe block. It runs against the
+// scope's own *testing.T, so a TempDir or Cleanup it registers lasts until the
+// scope ends. If it fails, the scope and every test in it fail without running.
+func BeforeAll[C TestClosure](beforeAllHook C, t *testing.T) {
+	scope := scopeOf(t)
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	scope.beforeAll = append(scope.beforeAll, toTestBody(beforeAllHook))
+}
+
+// BeforeEach registers a hook that runs before every test of the enclosing test
+// function or Describe block, including tests of nested Describe blocks. It runs
+// inside the test, so a failure is reported as a failure of that test.
+func BeforeEach[C TestClosure](beforeEachHook C, t *testing.T) {
+	scope := scopeOf(t)
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	scope.beforeEach = append(scope.beforeEach, toTestBody(beforeEachHook))
+}
+
+// AfterEach registers a hook that runs after every test of the enclosing test
+// function or Describe block, even when the test or its BeforeEach hooks failed.
+func AfterEach[C TestClosure](afterEachHook C, t *testing.T) {
+	scope := scopeOf(t)
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	scope.afterEach = append(scope.afterEach, toTestBody(afterEachHook))
+}
+
+// AfterAll registers a hook that runs once all tests of the enclosing test
+// function or Describe block have finished, against the scope's own *testing.T.
+// Its failure is reported together with the names of the tests it ran after.
+func AfterAll[C TestClosure](afterAllHook C, t *testing.T) {
+	scope := scopeOf(t)
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	scope.afterAll = append(scope.afterAll, toTestBody(afterAllHook))
+}
+
+// run executes testBody as the test named testName, surrounded by the hooks of
+// the scope and its ancestors: BeforeAll and BeforeEach outermost first,
+// AfterEach innermost first.
+func (scope *hookScope) run(testName string, t *testing.T, testBody func()) {
+	for s := scope; s != nil; s = s.parent {
+		s.recordTest(testName)
+	}
+
+	if scope.hasFailedBeforeAll() {
+		printHookNote(t, "BeforeAll hook failed, so the test did not run")
+		t.Fail()
+		return
+	}
+
+	defer func() { runAfterEach(scope.afterEachChain(), t) }()
+
+	chain := []*hookScope{}
+	for s := scope; s != nil; s = s.parent {
+		chain = append([]*hookScope{s}, chain...)
+	}
+	for _, s := range chain {
+		if !runHooks(s.hooks(&s.beforeEach), t) {
+			printHookNote(t, "BeforeEach hook failed, so the test did not run")
+			return
+		}
+	}
+	testBody()
+}
+
+// afterEachChain returns the AfterEach hooks of the scope and its ancestors, in
+// the order they run: innermost first.
+func (scope *hookScope) afterEachChain() []testBody {
+	hooks := []testBody{}
+	for s := scope; s != nil; s = s.parent {
+		hooks = append(hooks, s.hooks(&s.afterEach)...)
+	}
+	return hooks
+}
+
+// runAfterEach runs every AfterEach hook, even after one failed, so that no
+// scope's cleanup is left undone. Each runs from a deferred call of the one
+// before, so the rest still run when a hook stops the test with FailNow, as
+// Must and t.Fatal do.
+func runAfterEach(hooks []testBody, t *testing.T) {
+	if len(hooks) == 0 {
+		return
+	}
+	passed := false
+	defer func() {
+		if !passed {
+			printHookNote(t, "AfterEach hook failed")
+			t.Fail()
+		}
+		runAfterEach(hooks[1:], t)
+	}()
+	passed = runHooks(hooks[:1], t)
+}
+
+// runBeforeAll runs the BeforeAll hooks of the scope that have not run yet,
+// against the scope's own *testing.T. It is called from the scope's goroutine,
+// by the Test or Describe call that starts its first test or Describe block, so
+// a hook can stop the scope with FailNow. The hooks of the enclosing scopes have
+// run by then, when the Describe block of this scope started.
+func (scope *hookScope) runBeforeAll() {
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	if scope.beforeAllRan {
+		return
+	}
+	scope.beforeAllRan = true
+	if len(scope.beforeAll) > 0 && !runHooks(scope.beforeAll, scope.t) {
+		scope.beforeAllFailed = true
+		printHookNote(scope.t, "BeforeAll hook failed, so the tests of this scope did not run")
+	}
+}
+
+// hasFailedBeforeAll reports whether a BeforeAll hook of the scope or of an
+// enclosing one failed.
+func (scope *hookScope) hasFailedBeforeAll() bool {
+	for s := scope; s != nil; s = s.parent {
+		s.mu.Lock()
+		failed := s.beforeAllFailed
+		s.mu.Unlock()
+		if failed {
+			return true
+		}
+	}
+	return false
+}
+
+func (scope *hookScope) runAfterAll() {
+	// AfterAll undoes what BeforeAll set up, so it runs once the BeforeAll hooks
+	// have, even if -run then left no test of the scope to run.
+	testNames := scope.ranTestNames()
+	if len(testNames) == 0 && !scope.ranBeforeAllHooks() {
+		return
+	}
+	if runHooks(scope.hooks(&scope.afterAll), scope.t) {
+		return
+	}
+	if len(testNames) == 0 {
+		printHookNote(scope.t, "AfterAll hook failed")
+		return
+	}
+	note := "AfterAll hook failed after these tests:"
+	for _, testName := range testNames {
+		note += "\n  " + firstNonBlankLine(testName)
+	}
+	printHookNote(scope.t, note)
+}
+
+func (scope *hookScope) ranBeforeAllHooks() bool {
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	return scope.beforeAllRan && len(scope.beforeAll) > 0
+}
+
+func (scope *hookScope) recordTest(testName string) {
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	scope.testNames = append(scope.testNames, testName)
+}
+
+func (scope *hookScope) ranTestNames() []string {
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	return append([]string{}, scope.testNames...)
+}
+
+// hooks returns a copy of one of the scope's hook lists, so the hooks can run
+// without holding the lock.
+func (scope *hookScope) hooks(list *[]testBody) []testBody {
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	return append([]testBody{}, *list...)
+}
+
+// runHooks runs the hooks in order under t, stopping at the first one that fails
+// the test or panics. It reports whether all passed.
+func runHooks(hooks []testBody, t *testing.T) bool {
+	for _, hook := range hooks {
+		// A hook fails either through its Expect (seen by the recorder) or, when
+		// it takes T, directly through t — visible as t turning failed.
+		recorder := &failureRecorder{TB: t}
+		failedBefore := t.Failed()
+		panicked := true
+		func() {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					printHookNote(t, fmt.Sprintf("hook panicked: %v", recovered))
+					t.Fail()
+				}
+			}()
+			hook(t, expect.New(recorder))
+			panicked = false
+		}()
+		if panicked || recorder.failed || (!failedBefore && t.Failed()) {
+			return false
+		}
+	}
+	return true
+}
+
+// failureRecorder forwards to the test it wraps and remembers whether an
+// expectation failed through it, so a hook's own failures can be told apart
+// from those of the test around it.
+type failureRecorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *failureRecorder) Fail() {
+	r.failed = true
+	r.TB.Fail()
+}
+
+func (r *failureRecorder) FailNow() {
+	r.failed = true
+	r.TB.FailNow()
+}
+
+func printHookNote(t testing.TB, note string) {
+	block := ""
+	for _, line := range strings.Split(note, "\n") {
+		block += "    " + ansi_escape.RED + line + ansi_escape.COLOR_RESET + "\n"
+	}
+	utils.PrintToTest(t, block)
+}
+
+// firstNonBlankLine shortens a (possibly multiline, Given/When/Then) test name to
+// its first meaningful line for use in a one-line note.
+func firstNonBlankLine(testName string) string {
+	for _, line := range strings.Split(testName, "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" {
+			return trimmed
+		}
+	}
+	return testName
+}
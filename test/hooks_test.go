@@ -0,0 +1,174 @@
+package goherent_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+	. "github.com/redjolr/goherent/test"
+)
+
+func TestHooksOrder(t *testing.T) {
+	calls := []string{}
+	t.Run("scope", func(t *testing.T) {
+		BeforeAll(func(Expect expect.F) { calls = append(calls, "before all") }, t)
+		BeforeEach(func(Expect expect.F) { calls = append(calls, "before each") }, t)
+		AfterEach(func(Expect expect.F) { calls = append(calls, "after each") }, t)
+		AfterAll(func(Expect expect.F) { calls = append(calls, "after all") }, t)
+
+		Test("first", func(Expect expect.F) { calls = append(calls, "first") }, t)
+		Test("second", func(Expect expect.F) { calls = append(calls, "second") }, t)
+	})
+
+	Test(`
+	Given a test function with BeforeAll, BeforeEach, AfterEach and AfterAll hooks
+	When two tests run in it
+	Then BeforeAll and AfterAll run once around them
+	And BeforeEach and AfterEach run around each test`, func(Expect expect.F) {
+		Expect(calls).ToEqual([]string{
+			"before all",
+			"before each", "first", "after each",
+			"before each", "second", "after each",
+			"after all",
+		})
+	}, t)
+}
+
+func TestHooksOfNestedDescribeBlocks(t *testing.T) {
+	calls := []string{}
+	t.Run("scope", func(t *testing.T) {
+		BeforeEach(func(Expect expect.F) { calls = append(calls, "outer before each") }, t)
+		AfterEach(func(Expect expect.F) { calls = append(calls, "outer after each") }, t)
+
+		Describe("inner", func(t *testing.T) {
+			BeforeAll(func(Expect expect.F) { calls = append(calls, "inner before all") }, t)
+			BeforeEach(func(Expect expect.F) { calls = append(calls, "inner before each") }, t)
+			AfterEach(func(Expect expect.F) { calls = append(calls, "inner after each") }, t)
+			AfterAll(func(Expect expect.F) { calls = append(calls, "inner after all") }, t)
+
+			Test("inner test", func(Expect expect.F) { calls = append(calls, "inner test") }, t)
+		}, t)
+
+		Test("outer test", func(Expect expect.F) { calls = append(calls, "outer test") }, t)
+	})
+
+	Test(`
+	Given hooks registered on a test function and on a Describe block nested in it
+	When a test of the Describe block runs
+	Then the outer hooks wrap the inner hooks
+	And a test outside the Describe block only runs the outer hooks`, func(Expect expect.F) {
+		Expect(calls).ToEqual([]string{
+			"inner before all",
+			"outer before each", "inner before each",
+			"inner test",
+			"inner after each", "outer after each",
+			"inner after all",
+			"outer before each", "outer test", "outer after each",
+		})
+	}, t)
+}
+
+func TestHooksWithoutTests(t *testing.T) {
+	calls := []string{}
+	t.Run("scope", func(t *testing.T) {
+		BeforeAll(func(Expect expect.F) { calls = append(calls, "before all") }, t)
+		AfterAll(func(Expect expect.F) { calls = append(calls, "after all") }, t)
+	})
+
+	Test(`
+	Given a test function with BeforeAll and AfterAll hooks but no tests
+	When it runs
+	Then neither hook runs`, func(Expect expect.F) {
+		Expect(calls).ToHaveLength(0)
+	}, t)
+}
+
+func TestBeforeAllWithTheTestContext(t *testing.T) {
+	var dir string
+	var dirExists []bool
+	t.Run("scope", func(t *testing.T) {
+		BeforeAll(func(t *T) { dir = t.TempDir() }, t)
+
+		for _, name := range []string{"first", "second"} {
+			Test(name, func(Expect expect.F) {
+				_, err := os.Stat(dir)
+				dirExists = append(dirExists, err == nil)
+			}, t)
+		}
+	})
+
+	Test(`
+	Given a BeforeAll hook that creates a TempDir through its test context
+	When two tests of its scope run
+	Then the directory is still there in the second test`, func(Expect expect.F) {
+		Expect(dirExists).ToEqual([]bool{true, true})
+	}, t)
+}
+
+// runInChildProcess runs the tests matching run in a child test process, with
+// GOHERENT_HOOKS_SCENARIO set to scenario, and returns its output and error. It
+// runs the scenarios that fail a test on purpose, or need their own -run.
+func runInChildProcess(scenario, run string) (string, error) {
+	cmd := exec.Command(os.Args[0], "-test.run="+run, "-test.v")
+	cmd.Env = append(os.Environ(), "GOHERENT_HOOKS_SCENARIO="+scenario)
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
+
+func TestFailingAfterEachHooks(t *testing.T) {
+	if scenario := os.Getenv("GOHERENT_HOOKS_SCENARIO"); scenario != "" {
+		calls := []string{}
+		t.Cleanup(func() { fmt.Printf("calls: %s\n", strings.Join(calls, ", ")) })
+		AfterEach(func(Expect expect.F) { calls = append(calls, "outer after each") }, t)
+		Describe("inner", func(t *testing.T) {
+			AfterEach(func(Expect expect.F) {
+				calls = append(calls, "first inner after each")
+				if scenario == "must" {
+					Expect(true).Must().ToBeFalse()
+				}
+				Expect(true).ToBeFalse()
+			}, t)
+			AfterEach(func(Expect expect.F) { calls = append(calls, "second inner after each") }, t)
+
+			Test("inner test", func(Expect expect.F) {}, t)
+		}, t)
+		return
+	}
+
+	for _, scenario := range []string{"soft", "must"} {
+		output, err := runInChildProcess(scenario, "^TestFailingAfterEachHooks$")
+
+		Test(`
+		Given AfterEach hooks on a test function and on a Describe block nested in it
+		When the first AfterEach hook of the Describe block fails, with a `+scenario+` expectation
+		Then the other AfterEach hooks still run
+		And the test fails`, func(Expect expect.F) {
+			Expect(err).ToBeError()
+			Expect(output).ToContain("calls: first inner after each, second inner after each, outer after each")
+			Expect(strings.Count(output, "AfterEach hook failed")).ToEqual(1)
+		}, t)
+	}
+}
+
+func TestAfterAllWithoutTestsToRun(t *testing.T) {
+	if os.Getenv("GOHERENT_HOOKS_SCENARIO") == "no tests run" {
+		BeforeAll(func(Expect expect.F) { fmt.Println("before all") }, t)
+		AfterAll(func(Expect expect.F) { fmt.Println("after all") }, t)
+		Test("some test", func(Expect expect.F) {}, t)
+		return
+	}
+
+	output, err := runInChildProcess("no tests run", "^TestAfterAllWithoutTestsToRun$/nomatch")
+
+	Test(`
+	Given a test function with BeforeAll and AfterAll hooks
+	When -run leaves none of its tests to run
+	Then AfterAll still runs after BeforeAll`, func(Expect expect.F) {
+		Expect(err).NotToBeError()
+		Expect(output).ToContain("before all\n")
+		Expect(output).ToContain("after all\n")
+	}, t)
+}
@@ -0,0 +1,32 @@
+package goherent_test
+
+import (
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+	. "github.com/redjolr/goherent/test"
+)
+
+func TestParallelTests(t *testing.T) {
+	calls := []string{}
+	t.Run("scope", func(t *testing.T) {
+		BeforeAll(func(Expect expect.F) { calls = append(calls, "before all") }, t)
+		AfterAll(func(Expect expect.F) { calls = append(calls, "after all") }, t)
+
+		TestParallel("parallel", func(Expect expect.F) { calls = append(calls, "parallel") }, t)
+		calls = append(calls, "end of test function")
+	})
+
+	Test(`
+	Given a test defined with TestParallel
+	When its test function runs
+	Then the test is paused until the test function returns, like t.Parallel()
+	And BeforeAll and AfterAll still run around it`, func(Expect expect.F) {
+		Expect(calls).ToEqual([]string{
+			"before all",
+			"end of test function",
+			"parallel",
+			"after all",
+		})
+	}, t)
+}
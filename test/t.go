@@ -0,0 +1,46 @@
+package goherent
+
+import (
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+)
+
+// T is the context handed to a test closure of the form func(t *T). It is the
+// subtest's own *testing.T — so Cleanup, TempDir, Setenv, Parallel, Log, Skipf
+// and the rest work as in a plain t.Run — together with the test's Expect.
+// In a BeforeAll or AfterAll hook it is the *testing.T of the hook's test
+// function or Describe block.
+//
+//	Test("it writes the config", func(t *T) {
+//		dir := t.TempDir()
+//		t.Expect(WriteConfig(dir)).NotToBeError()
+//	}, t)
+type T struct {
+	*testing.T
+	Expect expect.F
+}
+
+// TestClosure is the body of a test or a hook. It either receives only Expect,
+// or the full test context T.
+type TestClosure interface {
+	func(Expect expect.F) | func(t *T)
+}
+
+// testBody is a TestClosure normalized to receive both the *testing.T it runs
+// under and the Expect bound to it.
+type testBody func(t *testing.T, Expect expect.F)
+
+func toTestBody[C TestClosure](closure C) testBody {
+	switch closure := any(closure).(type) {
+	case func(Expect expect.F):
+		return func(t *testing.T, Expect expect.F) {
+			closure(Expect)
+		}
+	case func(t *T):
+		return func(t *testing.T, Expect expect.F) {
+			closure(&T{T: t, Expect: Expect})
+		}
+	}
+	panic("unreachable: TestClosure has exactly two forms")
+}
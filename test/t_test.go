@@ -0,0 +1,51 @@
+package goherent_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+	. "github.com/redjolr/goherent/test"
+)
+
+func TestTestContext(t *testing.T) {
+	Test(`
+	Given a test closure that takes the test context T
+	When it creates a temporary directory and sets an environment variable
+	Then both are available inside the test`, func(t *T) {
+		dir := t.TempDir()
+		t.Setenv("GOHERENT_CONTEXT_TEST", "set")
+
+		_, err := os.Stat(dir)
+		t.Expect(err).NotToBeError()
+		t.Expect(os.Getenv("GOHERENT_CONTEXT_TEST")).ToEqual("set")
+	}, t)
+
+	cleanedUp := false
+	t.Run("scope", func(t *testing.T) {
+		Test("registers a cleanup", func(t *T) {
+			t.Cleanup(func() { cleanedUp = true })
+		}, t)
+	})
+	Test(`
+	Given a test closure that registered a cleanup through T
+	When the test has finished
+	Then the cleanup has run`, func(Expect expect.F) {
+		Expect(cleanedUp).ToBeTrue()
+	}, t)
+}
+
+func TestHooksWithTestContext(t *testing.T) {
+	var dir string
+	BeforeEach(func(t *T) {
+		dir = t.TempDir()
+	}, t)
+
+	Test(`
+	Given a BeforeEach hook that takes the test context T
+	When it creates a temporary directory
+	Then the directory belongs to the test the hook ran for`, func(t *T) {
+		_, err := os.Stat(dir)
+		t.Expect(err).NotToBeError()
+	}, t)
+}
@@ -7,10 +7,29 @@ import (
 	"github.com/redjolr/goherent/internal"
 )
 
-func Test(name string, testClosure func(Expect expect.F), t *testing.T) {
+func Test[C TestClosure](name string, testClosure C, t *testing.T) {
+	if isPackageFocused() {
+		skipByFocus(name, t)
+		return
+	}
+	unfocusedTestsRun.Add(1)
 	testName := internal.EncodeGoherentTestName(name)
+	body := toTestBody(testClosure)
+	scope := scopeOf(t)
+	scope.runBeforeAll()
 	t.Run(testName, func(t *testing.T) {
 		Expect := expect.New(t)
-		testClosure(Expect)
+		scope.run(name, t, func() {
+			body(t, Expect)
+		})
+	})
+}
+
+// skipByFocus reports a test that does not run because its package contains
+// TestOnly tests.
+func skipByFocus(name string, t *testing.T) {
+	testName := internal.EncodeGoherentMarkedTestName(internal.ENCODED_SKIPPED_BY_FOCUS, name)
+	t.Run(testName, func(t *testing.T) {
+		t.Skip("skipped by focus: this package contains TestOnly tests")
 	})
 }
@@ -0,0 +1,242 @@
+package goherent
+
+import (
+	"flag"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+	"github.com/redjolr/goherent/internal"
+)
+
+// TestOnly defines a focused test case. As long as a package contains a TestOnly
+// call, only its focused tests run; every other Test is reported as skipped by
+// focus. It is meant for a quick local iteration loop — the goherent runner warns
+// loudly whenever focused tests are present, so they don't slip into CI.
+func TestOnly[C TestClosure](name string, testClosure C, t *testing.T) {
+	testName := internal.EncodeGoherentMarkedTestName(internal.ENCODED_FOCUSED, name)
+	body := toTestBody(testClosure)
+	scope := scopeOf(t)
+	scope.runBeforeAll()
+	if ran := unfocusedTestsRun.Load(); ran > 0 && !isPackageFocused() {
+		t.Helper()
+		t.Errorf("TestOnly %q was found only after %d unfocused tests had run, so this run is not focused. "+
+			"goherent finds TestOnly up front in the Test functions of the package's _test.go files: "+
+			"call it directly from a Test function, and run the test binary in the package's directory", name, ran)
+	}
+	focusedTestRegistered.Store(true)
+	t.Run(testName, func(t *testing.T) {
+		Expect := expect.New(t)
+		scope.run(name, t, func() {
+			body(t, Expect)
+		})
+	})
+}
+
+// goherentImportPath is the import path of this package, through which test
+// files reach TestOnly.
+const goherentImportPath = "github.com/redjolr/goherent/test"
+
+var (
+	// focusedTestRegistered is set by the first TestOnly call of the process.
+	focusedTestRegistered atomic.Bool
+
+	// unfocusedTestsRun counts the tests run while the package wasn't focused,
+	// which a TestOnly found only afterwards can no longer skip.
+	unfocusedTestsRun atomic.Int64
+
+	packageFocusedTestsOnce sync.Once
+	packageFocusedTests     []string
+)
+
+// isPackageFocused reports whether a focused test runs in this test binary, so
+// the other tests are to be skipped. Tests run one after another, so a TestOnly
+// declared after a Test hasn't registered yet when that Test runs; the package's
+// test sources are scanned for the test functions that call TestOnly, and the
+// package counts as focused once one of them is selected by -run and -skip, or
+// once a TestOnly call has actually registered. go test runs the test binary in
+// the package's directory. A TestOnly the scan misses, called through a helper
+// or from a binary run elsewhere, fails its test function if unfocused tests ran
+// before it.
+func isPackageFocused() bool {
+	if focusedTestRegistered.Load() {
+		return true
+	}
+	packageFocusedTestsOnce.Do(func() {
+		dir, err := os.Getwd()
+		if err != nil {
+			return
+		}
+		packageFocusedTests = focusedTestFunctions(dir, buildContext())
+	})
+	for _, name := range packageFocusedTests {
+		if isTestSelected(name, flagValue("test.run"), flagValue("test.skip")) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildContext returns the build context the test binary was built with, so that
+// the scan ignores the test files the build excluded.
+func buildContext() build.Context {
+	ctx := build.Default
+	ctx.GOOS, ctx.GOARCH = runtime.GOOS, runtime.GOARCH
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ctx
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "-tags":
+			ctx.BuildTags = strings.Split(setting.Value, ",")
+		case "CGO_ENABLED":
+			ctx.CgoEnabled = setting.Value == "1"
+		}
+	}
+	return ctx
+}
+
+func flagValue(name string) string {
+	f := flag.Lookup(name)
+	if f == nil {
+		return ""
+	}
+	return f.Value.String()
+}
+
+// focusedTestFunctions returns the names of the test functions in dir that call
+// goherent's TestOnly, skipping the _test.go files ctx excludes from the build.
+func focusedTestFunctions(dir string, ctx build.Context) []string {
+	testFiles, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return nil
+	}
+	var names []string
+	fileSet := token.NewFileSet()
+	for _, testFile := range testFiles {
+		if match, err := ctx.MatchFile(dir, filepath.Base(testFile)); err != nil || !match {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, testFile, nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+		qualifiers, unqualified := testOnlyNames(file)
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Recv != nil || function.Body == nil || !strings.HasPrefix(function.Name.Name, "Test") {
+				continue
+			}
+			if callsTestOnly(function.Body, qualifiers, unqualified) {
+				names = append(names, function.Name.Name)
+			}
+		}
+	}
+	return names
+}
+
+// testOnlyNames returns the package names through which file reaches goherent's
+// TestOnly, and whether it can call it unqualified: from within this package or
+// through a dot-import.
+func testOnlyNames(file *ast.File) (qualifiers map[string]bool, unqualified bool) {
+	qualifiers = map[string]bool{}
+	unqualified = file.Name.Name == "goherent"
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != goherentImportPath {
+			continue
+		}
+		switch {
+		case spec.Name == nil:
+			qualifiers["goherent"] = true
+		case spec.Name.Name == ".":
+			unqualified = true
+		case spec.Name.Name != "_":
+			qualifiers[spec.Name.Name] = true
+		}
+	}
+	return qualifiers, unqualified
+}
+
+func callsTestOnly(body *ast.BlockStmt, qualifiers map[string]bool, unqualified bool) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if ok && isTestOnlyFunc(call.Fun, qualifiers, unqualified) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+func isTestOnlyFunc(fun ast.Expr, qualifiers map[string]bool, unqualified bool) bool {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		return unqualified && fun.Name == "TestOnly"
+	case *ast.SelectorExpr:
+		pkg, ok := fun.X.(*ast.Ident)
+		return ok && qualifiers[pkg.Name] && fun.Sel.Name == "TestOnly"
+	case *ast.IndexExpr:
+		// An explicitly instantiated TestOnly[func(t *T)](...).
+		return isTestOnlyFunc(fun.X, qualifiers, unqualified)
+	}
+	return false
+}
+
+// isTestSelected reports whether go test runs the top-level test name given the
+// -run and -skip patterns. Only the first, top-level, element of -run is
+// considered; a -skip pattern with more elements skips subtests, not name itself.
+func isTestSelected(name, run, skip string) bool {
+	if run != "" && !matchesPattern(name, splitPattern(run)[0]) {
+		return false
+	}
+	if skip == "" {
+		return true
+	}
+	elements := splitPattern(skip)
+	return len(elements) > 1 || !matchesPattern(name, elements[0])
+}
+
+// matchesPattern treats an invalid pattern as matching, leaving it to go test to
+// report.
+func matchesPattern(name, pattern string) bool {
+	re, err := regexp.Compile(pattern)
+	return err != nil || re.MatchString(name)
+}
+
+// splitPattern splits a -run or -skip pattern into its slash-separated elements,
+// ignoring slashes within brackets and parentheses or escaped, as go test does.
+func splitPattern(pattern string) []string {
+	var elements []string
+	depth, start := 0, 0
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		case '/':
+			if depth == 0 {
+				elements = append(elements, pattern[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(elements, pattern[start:])
+}
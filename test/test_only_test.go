@@ -0,0 +1,33 @@
+package goherent_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+	. "github.com/redjolr/goherent/test"
+)
+
+// focusThroughHelper calls TestOnly from a function that isn't a Test function,
+// where the scan for focused tests doesn't look.
+func focusThroughHelper(t *testing.T) {
+	TestOnly("focused test", func(Expect expect.F) {}, t)
+}
+
+func TestFocusFoundLate(t *testing.T) {
+	if os.Getenv("GOHERENT_HOOKS_SCENARIO") == "focus found late" {
+		Test("unfocused test", func(Expect expect.F) {}, t)
+		focusThroughHelper(t)
+		return
+	}
+
+	output, err := runInChildProcess("focus found late", "^TestFocusFoundLate$")
+
+	Test(`
+	Given a TestOnly call that the scan for focused tests misses
+	When unfocused tests have run before it
+	Then the test function fails, explaining that the run is not focused`, func(Expect expect.F) {
+		Expect(err).ToBeError()
+		Expect(output).ToContain(`TestOnly "focused test" was found only after 1 unfocused tests had run, so this run is not focused.`)
+	}, t)
+}
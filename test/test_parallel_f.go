@@ -0,0 +1,31 @@
+package goherent
+
+import (
+	"testing"
+
+	"github.com/redjolr/goherent/expect"
+	"github.com/redjolr/goherent/internal"
+)
+
+// TestParallel defines a test case that runs in parallel with the other parallel
+// tests of its test function or Describe block, like t.Parallel() in a plain
+// subtest. Hooks work as with Test: BeforeAll runs once before the first of them,
+// and AfterAll once they have all finished.
+func TestParallel[C TestClosure](name string, testClosure C, t *testing.T) {
+	if isPackageFocused() {
+		skipByFocus(name, t)
+		return
+	}
+	unfocusedTestsRun.Add(1)
+	testName := internal.EncodeGoherentTestName(name)
+	body := toTestBody(testClosure)
+	scope := scopeOf(t)
+	scope.runBeforeAll()
+	t.Run(testName, func(t *testing.T) {
+		t.Parallel()
+		Expect := expect.New(t)
+		scope.run(name, t, func() {
+			body(t, Expect)
+		})
+	})
+}
@@ -7,11 +7,12 @@ import (
 	"github.com/redjolr/goherent/internal"
 )
 
-func TestSkip(name string, testClosure func(Expect expect.F), t *testing.T) {
+func TestSkip[C TestClosure](name string, testClosure C, t *testing.T) {
 	testName := internal.EncodeGoherentTestName(name)
+	body := toTestBody(testClosure)
 	t.Run(testName, func(t *testing.T) {
 		t.Skip()
 		Expect := expect.New(t)
-		testClosure(Expect)
+		body(t, Expect)
 	})
 }
@@ -0,0 +1,17 @@
+package goherent
+
+import (
+	"testing"
+
+	"github.com/redjolr/goherent/internal"
+)
+
+// TestTodo declares a placeholder for a test that is yet to be written. It is
+// skipped, and the goherent runner counts it in its own "todo" category rather
+// than among the skipped tests.
+func TestTodo(description string, t *testing.T) {
+	testName := internal.EncodeGoherentMarkedTestName(internal.ENCODED_TODO, description)
+	t.Run(testName, func(t *testing.T) {
+		t.Skip("todo")
+	})
+}